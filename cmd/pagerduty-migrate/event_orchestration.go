@@ -0,0 +1,607 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/heimweh/go-pagerduty/pagerduty"
+	"github.com/terraform-providers/terraform-provider-pagerduty/internal/eventrule"
+)
+
+func runEventOrchestration(args []string, stdin io.Reader, stdout, stderr io.Writer) error {
+	fs := flag.NewFlagSet("event-orchestration", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	ruleset := fs.String("ruleset", "", "ID of the ruleset whose rules are converted")
+	service := fs.String("service", "", "ID of the service whose event rules are converted")
+	orchestration := fs.String("event-orchestration", "", "ID of, or reference to, the event orchestration replacing the ruleset (required with -ruleset)")
+	apiURL := fs.String("api-url", "", "base URL of the PagerDuty API")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	if (*ruleset == "") == (*service == "") {
+		return fmt.Errorf("exactly one of -ruleset and -service is required")
+	}
+	if *ruleset != "" && *orchestration == "" {
+		return fmt.Errorf("-event-orchestration is required with -ruleset")
+	}
+
+	token := os.Getenv("PAGERDUTY_TOKEN")
+	if token == "" {
+		return fmt.Errorf("PAGERDUTY_TOKEN must be set")
+	}
+
+	client, err := pagerduty.NewClient(&pagerduty.Config{
+		BaseURL: *apiURL,
+		Token:   token,
+	})
+	if err != nil {
+		return err
+	}
+
+	w := new(hclWriter)
+	var warnings []string
+
+	if *ruleset != "" {
+		warnings, err = writeRulesetOrchestration(w, client, *ruleset, referenceExpr(*orchestration))
+	} else {
+		warnings, err = writeServiceOrchestration(w, client, *service)
+	}
+	if err != nil {
+		return err
+	}
+
+	for _, warning := range warnings {
+		fmt.Fprintf(stderr, "Warning: %s\n", warning)
+	}
+
+	_, err = io.WriteString(stdout, w.String())
+	return err
+}
+
+func writeRulesetOrchestration(w *hclWriter, client *pagerduty.Client, id string, orchestration interface{}) ([]string, error) {
+	ruleset, _, err := client.Rulesets.Get(id)
+	if err != nil {
+		return nil, fmt.Errorf("reading ruleset %s: %v", id, err)
+	}
+
+	resp, _, err := client.Rulesets.ListRules(id)
+	if err != nil {
+		return nil, fmt.Errorf("reading rules of ruleset %s: %v", id, err)
+	}
+
+	router, unrouted, warnings, err := convertRulesetRules(resp.Rules)
+	if err != nil {
+		return nil, fmt.Errorf("converting ruleset %s: %v", id, err)
+	}
+	name := labelName(ruleset.Name)
+
+	w.comment("Converted from ruleset %s (%s)", ruleset.Name, ruleset.ID)
+	for _, warning := range warnings {
+		w.comment("WARNING: %s", warning)
+	}
+	writeEventOrchestrationPath(w, "pagerduty_event_orchestration_router", name, "event_orchestration", orchestration, router)
+
+	if unrouted != nil {
+		w.blank()
+		writeEventOrchestrationPath(w, "pagerduty_event_orchestration_unrouted", name, "event_orchestration", orchestration, unrouted)
+	}
+
+	for i, warning := range warnings {
+		warnings[i] = fmt.Sprintf("ruleset %s: %s", ruleset.ID, warning)
+	}
+
+	return warnings, nil
+}
+
+func writeServiceOrchestration(w *hclWriter, client *pagerduty.Client, id string) ([]string, error) {
+	service, _, err := client.Services.Get(id, nil)
+	if err != nil {
+		return nil, fmt.Errorf("reading service %s: %v", id, err)
+	}
+
+	var rules []*pagerduty.ServiceEventRule
+	o := &pagerduty.ListServiceEventRuleOptions{}
+	for {
+		resp, _, err := client.Services.ListEventRules(id, o)
+		if err != nil {
+			return nil, fmt.Errorf("reading event rules of service %s: %v", id, err)
+		}
+
+		rules = append(rules, resp.EventRules...)
+
+		if !resp.More {
+			break
+		}
+		o.Offset = resp.Offset + len(resp.EventRules)
+	}
+
+	path, warnings, err := convertServiceEventRules(rules)
+	if err != nil {
+		return nil, fmt.Errorf("converting event rules of service %s: %v", id, err)
+	}
+
+	w.comment("Converted from the event rules of service %s (%s)", service.Name, service.ID)
+	for _, warning := range warnings {
+		w.comment("WARNING: %s", warning)
+	}
+	writeEventOrchestrationPath(w, "pagerduty_event_orchestration_service", labelName(service.Name), "service", service.ID, path)
+
+	for i, warning := range warnings {
+		warnings[i] = fmt.Sprintf("service %s: %s", service.ID, warning)
+	}
+
+	return warnings, nil
+}
+
+// convertRulesetRules splits the rules of a ruleset between the router and
+// the unrouted rules of an event orchestration. The router only routes, so
+// rules that route are added to it and all other rules become unrouted rules.
+// unrouted is nil when there is nothing to add to the unrouted rules.
+func convertRulesetRules(rules []*pagerduty.RulesetRule) (router, unrouted *pagerduty.EventOrchestrationPath, warnings []string, err error) {
+	sort.SliceStable(rules, func(i, j int) bool {
+		return rulePosition(rules[i].Position) < rulePosition(rules[j].Position)
+	})
+
+	routerSet := &pagerduty.EventOrchestrationPathSet{ID: "start"}
+	unroutedSet := &pagerduty.EventOrchestrationPathSet{ID: "start"}
+	routerCatchAll := &pagerduty.EventOrchestrationPathRuleActions{RouteTo: "unrouted"}
+	unroutedCatchAll := &pagerduty.EventOrchestrationPathRuleActions{}
+
+	warn := func(r *pagerduty.RulesetRule, format string, a ...interface{}) {
+		warnings = append(warnings, fmt.Sprintf("rule %s: %s", r.ID, fmt.Sprintf(format, a...)))
+	}
+
+	for _, r := range rules {
+		actions, actionWarnings := convertRuleActions(r.Actions, r.Variables)
+		for _, aw := range actionWarnings {
+			warn(r, "%s", aw)
+		}
+
+		if r.CatchAll {
+			if actions.RouteTo != "" {
+				routerCatchAll.RouteTo = actions.RouteTo
+				actions.RouteTo = ""
+				if dropped := restrictActions(actions, pagerduty.PathTypeRouter); len(dropped) > 0 {
+					warn(r, "the catch-all rule routes to %s, so %s must be added to the orchestration of that service instead", routerCatchAll.RouteTo, strings.Join(dropped, ", "))
+				}
+				continue
+			}
+			if dropped := restrictActions(actions, pagerduty.PathTypeUnrouted); len(dropped) > 0 {
+				warn(r, "%s cannot be applied to unrouted events", strings.Join(dropped, ", "))
+			}
+			unroutedCatchAll = actions
+			continue
+		}
+
+		conditions, disable, conditionWarnings, err := convertRuleConditions(r.Conditions, r.TimeFrame)
+		if err != nil {
+			return nil, nil, nil, fmt.Errorf("rule %s: %v", r.ID, err)
+		}
+		for _, cw := range conditionWarnings {
+			warn(r, "%s", cw)
+		}
+
+		rule := &pagerduty.EventOrchestrationPathRule{
+			Label:      fmt.Sprintf("Converted from rule %s", r.ID),
+			Conditions: conditions,
+			Actions:    actions,
+			Disabled:   r.Disabled || disable,
+		}
+
+		if actions.RouteTo == "" {
+			if dropped := restrictActions(actions, pagerduty.PathTypeUnrouted); len(dropped) > 0 {
+				warn(r, "%s cannot be applied to unrouted events", strings.Join(dropped, ", "))
+			}
+			unroutedSet.Rules = append(unroutedSet.Rules, rule)
+			continue
+		}
+
+		if dropped := restrictActions(actions, pagerduty.PathTypeRouter); len(dropped) > 0 {
+			warn(r, "the router only routes, so %s must be added to the orchestration of service %s instead", strings.Join(dropped, ", "), actions.RouteTo)
+		}
+		if len(unroutedSet.Rules) > 0 {
+			warn(r, "this rule follows rules that do not route; events matching both were left unrouted by the ruleset but are now routed by this rule")
+		}
+		routerSet.Rules = append(routerSet.Rules, rule)
+	}
+
+	router = &pagerduty.EventOrchestrationPath{
+		Type:     pagerduty.PathTypeRouter,
+		Sets:     []*pagerduty.EventOrchestrationPathSet{routerSet},
+		CatchAll: &pagerduty.EventOrchestrationPathCatchAll{Actions: routerCatchAll},
+	}
+
+	if len(unroutedSet.Rules) > 0 || !isEmptyRuleActions(unroutedCatchAll) {
+		unrouted = &pagerduty.EventOrchestrationPath{
+			Type:     pagerduty.PathTypeUnrouted,
+			Sets:     []*pagerduty.EventOrchestrationPathSet{unroutedSet},
+			CatchAll: &pagerduty.EventOrchestrationPathCatchAll{Actions: unroutedCatchAll},
+		}
+	}
+
+	return router, unrouted, warnings, nil
+}
+
+// convertServiceEventRules converts the event rules of a service into the
+// start set of its service orchestration.
+func convertServiceEventRules(rules []*pagerduty.ServiceEventRule) (*pagerduty.EventOrchestrationPath, []string, error) {
+	sort.SliceStable(rules, func(i, j int) bool {
+		return rulePosition(rules[i].Position) < rulePosition(rules[j].Position)
+	})
+
+	var warnings []string
+	set := &pagerduty.EventOrchestrationPathSet{ID: "start"}
+
+	for _, r := range rules {
+		actions, actionWarnings := convertRuleActions(r.Actions, r.Variables)
+		conditions, disable, conditionWarnings, err := convertRuleConditions(r.Conditions, r.TimeFrame)
+		if err != nil {
+			return nil, nil, fmt.Errorf("rule %s: %v", r.ID, err)
+		}
+		for _, warning := range append(actionWarnings, conditionWarnings...) {
+			warnings = append(warnings, fmt.Sprintf("rule %s: %s", r.ID, warning))
+		}
+
+		set.Rules = append(set.Rules, &pagerduty.EventOrchestrationPathRule{
+			Label:      fmt.Sprintf("Converted from rule %s", r.ID),
+			Conditions: conditions,
+			Actions:    actions,
+			Disabled:   r.Disabled || disable,
+		})
+	}
+
+	return &pagerduty.EventOrchestrationPath{
+		Type:     pagerduty.PathTypeService,
+		Sets:     []*pagerduty.EventOrchestrationPathSet{set},
+		CatchAll: &pagerduty.EventOrchestrationPathCatchAll{Actions: &pagerduty.EventOrchestrationPathRuleActions{}},
+	}, warnings, nil
+}
+
+func rulePosition(p *int) int {
+	if p == nil {
+		return 0
+	}
+	return *p
+}
+
+// convertRuleConditions converts rule conditions and a time frame into
+// orchestration conditions. Orchestration conditions match if any of them
+// match, so "and" conditions become a single expression and "or" conditions
+// one expression per subcondition. The time frame is added to each of them.
+// Dropping a subcondition would widen the rule, so a subcondition that can't
+// be converted is an error. So would dropping the time frame, so a rule whose
+// time frame can't be converted is disabled, with a warning.
+func convertRuleConditions(c *pagerduty.RuleConditions, tf *pagerduty.RuleTimeFrame) ([]*pagerduty.EventOrchestrationPathRuleCondition, bool, []string, error) {
+	var warnings []string
+	var expressions []string
+
+	if c != nil {
+		for _, sc := range c.RuleSubconditions {
+			e, err := subconditionExpression(sc)
+			if err != nil {
+				return nil, false, nil, err
+			}
+			expressions = append(expressions, e)
+		}
+
+		if c.Operator == "and" && len(expressions) > 1 {
+			expressions = []string{strings.Join(expressions, " and ")}
+		}
+	}
+
+	disable := false
+	if tf != nil {
+		timeFrame, err := timeFrameExpression(tf)
+		if err != nil {
+			warnings = append(warnings, fmt.Sprintf("%s; the rule is disabled until its time frame is added to its conditions", err))
+			disable = true
+		} else if len(expressions) == 0 {
+			expressions = []string{timeFrame}
+		} else {
+			for i := range expressions {
+				expressions[i] += " and " + timeFrame
+			}
+		}
+	}
+
+	conditions := make([]*pagerduty.EventOrchestrationPathRuleCondition, len(expressions))
+	for i, e := range expressions {
+		conditions[i] = &pagerduty.EventOrchestrationPathRuleCondition{Expression: e}
+	}
+
+	return conditions, disable, warnings, nil
+}
+
+// subconditionExpression converts a subcondition such as
+// {"operator": "contains", "parameters": {"path": "payload.source", "value": "db"}}
+// into the PCL expression event.source matches part 'db'.
+func subconditionExpression(sc *pagerduty.RuleSubcondition) (string, error) {
+	base, negated, ok := eventrule.SubconditionOperator(sc.Operator)
+	if !ok {
+		return "", fmt.Errorf("unknown subcondition operator %q", sc.Operator)
+	}
+	if sc.Parameters == nil {
+		return "", fmt.Errorf("subcondition %q has no parameters", sc.Operator)
+	}
+
+	field := orchestrationPath(sc.Parameters.Path)
+	value := pclString(sc.Parameters.Value)
+
+	var e string
+	switch base {
+	case "equals":
+		e = fmt.Sprintf("%s matches %s", field, value)
+	case "contains":
+		e = fmt.Sprintf("%s matches part %s", field, value)
+	case "matches":
+		e = fmt.Sprintf("%s matches regex %s", field, value)
+	case "exists":
+		e = fmt.Sprintf("%s exists", field)
+	default:
+		return "", fmt.Errorf("subcondition operator %q has no orchestration equivalent", sc.Operator)
+	}
+
+	if negated {
+		e = "not " + e
+	}
+	return e, nil
+}
+
+var pclWeekdays = map[int]string{1: "Mon", 2: "Tue", 3: "Wed", 4: "Thu", 5: "Fri", 6: "Sat", 7: "Sun"}
+
+// timeFrameExpression converts a time frame into a PCL time condition.
+// Weekly schedules that cross midnight cannot be expressed as a single
+// condition and are returned as an error.
+func timeFrameExpression(tf *pagerduty.RuleTimeFrame) (string, error) {
+	if sw := tf.ScheduledWeekly; sw != nil {
+		loc, err := time.LoadLocation(sw.Timezone)
+		if err != nil {
+			return "", fmt.Errorf("scheduled_weekly time zone %q is unknown", sw.Timezone)
+		}
+
+		start := time.Unix(0, int64(sw.StartTime)*int64(time.Millisecond)).In(loc)
+		end := start.Add(time.Duration(sw.Duration) * time.Millisecond)
+		if sw.Duration >= int(24*time.Hour/time.Millisecond) || end.YearDay() != start.YearDay() {
+			return "", fmt.Errorf("scheduled_weekly crosses midnight")
+		}
+
+		days := make([]string, 0, len(sw.Weekdays))
+		for _, d := range sw.Weekdays {
+			day, ok := pclWeekdays[d]
+			if !ok {
+				return "", fmt.Errorf("scheduled_weekly weekday %d is invalid", d)
+			}
+			days = append(days, day)
+		}
+
+		return fmt.Sprintf("now in %s %s to %s %s", strings.Join(days, ","), start.Format("15:04:05"), end.Format("15:04:05"), sw.Timezone), nil
+	}
+
+	if ab := tf.ActiveBetween; ab != nil {
+		start := time.Unix(0, int64(ab.StartTime)*int64(time.Millisecond)).UTC()
+		end := time.Unix(0, int64(ab.EndTime)*int64(time.Millisecond)).UTC()
+		return fmt.Sprintf("now in %s to %s UTC", start.Format("2006-01-02 15:04:05"), end.Format("2006-01-02 15:04:05")), nil
+	}
+
+	return "", fmt.Errorf("time frame is empty")
+}
+
+// orchestrationPath maps a rule path such as payload.source to the PCL field
+// it corresponds to, event.source. Fields outside of the payload are only
+// available through raw_event.
+func orchestrationPath(path string) string {
+	if strings.HasPrefix(path, "payload.") {
+		return "event." + strings.TrimPrefix(path, "payload.")
+	}
+	return "raw_event." + path
+}
+
+// pclString quotes s as a PCL string literal.
+func pclString(s string) string {
+	s = strings.ReplaceAll(s, `\`, `\\`)
+	s = strings.ReplaceAll(s, `'`, `\'`)
+	return "'" + s + "'"
+}
+
+// convertRuleActions converts rule actions and variables into orchestration
+// actions, which include the variables.
+func convertRuleActions(a *pagerduty.RuleActions, variables []*pagerduty.RuleVariable) (*pagerduty.EventOrchestrationPathRuleActions, []string) {
+	var warnings []string
+	actions := &pagerduty.EventOrchestrationPathRuleActions{}
+
+	for _, v := range variables {
+		if v.Parameters == nil {
+			warnings = append(warnings, fmt.Sprintf("variable %q has no parameters and was not converted", v.Name))
+			continue
+		}
+		actions.Variables = append(actions.Variables, &pagerduty.EventOrchestrationPathActionVariables{
+			Name:  v.Name,
+			Path:  orchestrationPath(v.Parameters.Path),
+			Type:  v.Type,
+			Value: v.Parameters.Value,
+		})
+	}
+
+	if a == nil {
+		return actions, warnings
+	}
+
+	if a.Route != nil {
+		actions.RouteTo = a.Route.Value
+	}
+	if a.Severity != nil {
+		actions.Severity = a.Severity.Value
+	}
+	if a.EventAction != nil {
+		actions.EventAction = a.EventAction.Value
+	}
+	if a.Priority != nil {
+		actions.Priority = a.Priority.Value
+	}
+	if a.Annotate != nil {
+		actions.Annotate = a.Annotate.Value
+	}
+	if a.Suspend != nil {
+		suspend := a.Suspend.Value
+		actions.Suspend = &suspend
+	}
+	if a.Suppress != nil {
+		actions.Suppress = a.Suppress.Value
+		if a.Suppress.ThresholdValue > 0 {
+			warnings = append(warnings, fmt.Sprintf("suppress threshold (%d events in %d %s) has no orchestration equivalent; all matching events are suppressed", a.Suppress.ThresholdValue, a.Suppress.ThresholdTimeAmount, a.Suppress.ThresholdTimeUnit))
+		}
+	}
+
+	for _, e := range a.Extractions {
+		extraction := &pagerduty.EventOrchestrationPathActionExtractions{
+			Target:   "event." + strings.TrimPrefix(e.Target, "payload."),
+			Template: e.Template,
+			Regex:    e.Regex,
+		}
+		if e.Source != "" {
+			extraction.Source = orchestrationPath(e.Source)
+		}
+		actions.Extractions = append(actions.Extractions, extraction)
+	}
+
+	return actions, warnings
+}
+
+// restrictActions clears the actions that paths of pathType do not support
+// and returns their names.
+func restrictActions(a *pagerduty.EventOrchestrationPathRuleActions, pathType string) []string {
+	var dropped []string
+
+	if pathType == pagerduty.PathTypeService {
+		return nil
+	}
+
+	if a.Suppress {
+		dropped = append(dropped, "suppress")
+		a.Suppress = false
+	}
+	if a.Suspend != nil {
+		dropped = append(dropped, "suspend")
+		a.Suspend = nil
+	}
+	if a.Priority != "" {
+		dropped = append(dropped, "priority")
+		a.Priority = ""
+	}
+	if a.Annotate != "" {
+		dropped = append(dropped, "annotate")
+		a.Annotate = ""
+	}
+
+	if pathType == pagerduty.PathTypeUnrouted {
+		return dropped
+	}
+
+	if a.Severity != "" {
+		dropped = append(dropped, "severity")
+		a.Severity = ""
+	}
+	if a.EventAction != "" {
+		dropped = append(dropped, "event_action")
+		a.EventAction = ""
+	}
+	if len(a.Variables) > 0 {
+		dropped = append(dropped, "variables")
+		a.Variables = nil
+	}
+	if len(a.Extractions) > 0 {
+		dropped = append(dropped, "extractions")
+		a.Extractions = nil
+	}
+
+	return dropped
+}
+
+func isEmptyRuleActions(a *pagerduty.EventOrchestrationPathRuleActions) bool {
+	return a.RouteTo == "" && !a.Suppress && a.Suspend == nil && a.Priority == "" && a.Annotate == "" &&
+		a.Severity == "" && a.EventAction == "" && len(a.Variables) == 0 && len(a.Extractions) == 0
+}
+
+func writeEventOrchestrationPath(w *hclWriter, resourceType, name, parentKey string, parent interface{}, path *pagerduty.EventOrchestrationPath) {
+	w.open("resource", resourceType, name)
+	w.attr(parentKey, parent)
+
+	for _, set := range path.Sets {
+		w.open("set")
+		w.attr("id", set.ID)
+		for _, rule := range set.Rules {
+			w.open("rule")
+			w.attr("label", rule.Label)
+			if rule.Disabled {
+				w.attr("disabled", true)
+			}
+			for _, c := range rule.Conditions {
+				w.open("condition")
+				w.attr("expression", c.Expression)
+				w.close()
+			}
+			writeEventOrchestrationActions(w, rule.Actions)
+			w.close()
+		}
+		w.close()
+	}
+
+	w.open("catch_all")
+	writeEventOrchestrationActions(w, path.CatchAll.Actions)
+	w.close()
+
+	w.close()
+}
+
+func writeEventOrchestrationActions(w *hclWriter, a *pagerduty.EventOrchestrationPathRuleActions) {
+	w.open("actions")
+	for _, p := range []struct {
+		name  string
+		value string
+	}{
+		{"route_to", a.RouteTo},
+		{"priority", a.Priority},
+		{"annotate", a.Annotate},
+		{"severity", a.Severity},
+		{"event_action", a.EventAction},
+	} {
+		if p.value != "" {
+			w.attr(p.name, p.value)
+		}
+	}
+	if a.Suppress {
+		w.attr("suppress", true)
+	}
+	if a.Suspend != nil {
+		w.attr("suspend", *a.Suspend)
+	}
+	for _, v := range a.Variables {
+		w.open("variable")
+		w.attr("name", v.Name)
+		w.attr("path", v.Path)
+		w.attr("type", v.Type)
+		w.attr("value", v.Value)
+		w.close()
+	}
+	for _, e := range a.Extractions {
+		w.open("extraction")
+		w.attr("target", e.Target)
+		if e.Template != "" {
+			w.attr("template", e.Template)
+		}
+		if e.Regex != "" {
+			w.attr("regex", e.Regex)
+		}
+		if e.Source != "" {
+			w.attr("source", e.Source)
+		}
+		w.close()
+	}
+	w.close()
+}
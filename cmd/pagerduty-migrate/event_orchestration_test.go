@@ -0,0 +1,245 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/heimweh/go-pagerduty/pagerduty"
+)
+
+var testEventOrchestrationAPI = map[string]string{
+	"/rulesets/R1": `{"ruleset": {"id": "R1", "name": "Global Rules"}}`,
+	"/rulesets/R1/rules": `{"rules": [
+  {
+    "id": "C1",
+    "position": 2,
+    "catch_all": true,
+    "actions": {"severity": {"value": "info"}}
+  },
+  {
+    "id": "A1",
+    "position": 1,
+    "conditions": {"operator": "or", "subconditions": [
+      {"operator": "contains", "parameters": {"path": "payload.source", "value": "db"}},
+      {"operator": "nmatches", "parameters": {"path": "payload.summary", "value": "^test's"}}
+    ]},
+    "actions": {"severity": {"value": "warning"}},
+    "variables": [{"name": "host", "type": "regex", "parameters": {"path": "payload.source", "value": "(.*)"}}]
+  },
+  {
+    "id": "B1",
+    "position": 0,
+    "disabled": true,
+    "conditions": {"operator": "and", "subconditions": [
+      {"operator": "equals", "parameters": {"path": "payload.severity", "value": "critical"}},
+      {"operator": "exists", "parameters": {"path": "routing_key"}}
+    ]},
+    "time_frame": {"scheduled_weekly": {"weekdays": [1, 2], "timezone": "America/Los_Angeles", "start_time": 1565392127032, "duration": 3600000}},
+    "actions": {"route": {"value": "PSVC1"}, "annotate": {"value": "Database"}}
+  }
+]}`,
+	"/services/S1": `{"service": {"id": "S1", "name": "My Web App"}}`,
+	"/services/S1/rules": `{"rules": [
+  {
+    "id": "E1",
+    "position": 0,
+    "conditions": {"operator": "and", "subconditions": [
+      {"operator": "ncontains", "parameters": {"path": "payload.custom_details.env", "value": "staging"}}
+    ]},
+    "time_frame": {"active_between": {"start_time": 1577836800000, "end_time": 1580515200000}},
+    "actions": {
+      "suppress": {"value": true, "threshold_value": 4, "threshold_time_unit": "minutes", "threshold_time_amount": 5},
+      "suspend": {"value": 300},
+      "priority": {"value": "PPRIO1"},
+      "extractions": [{"target": "summary", "source": "payload.source", "regex": "host:(.*)"}]
+    }
+  }
+]}`,
+}
+
+func testEventOrchestrationServer(t *testing.T) *httptest.Server {
+	os.Setenv("PAGERDUTY_TOKEN", "foo")
+	t.Cleanup(func() { os.Unsetenv("PAGERDUTY_TOKEN") })
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, ok := testEventOrchestrationAPI[r.URL.Path]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		fmt.Fprint(w, body)
+	}))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func TestRunEventOrchestrationRuleset(t *testing.T) {
+	server := testEventOrchestrationServer(t)
+	var stdout, stderr bytes.Buffer
+
+	code := run([]string{"event-orchestration", "-api-url", server.URL, "-ruleset", "R1", "-event-orchestration", "pagerduty_event_orchestration.global.id"}, nil, &stdout, &stderr)
+	if code != 0 {
+		t.Fatalf("exit code %d: %s", code, stderr.String())
+	}
+
+	out := stdout.String()
+	for _, want := range []string{
+		`resource "pagerduty_event_orchestration_router" "Global_Rules" {`,
+		`resource "pagerduty_event_orchestration_unrouted" "Global_Rules" {`,
+		`event_orchestration = pagerduty_event_orchestration.global.id`,
+		`expression = "event.severity matches 'critical' and raw_event.routing_key exists and now in Mon,Tue 16:08:47 to 17:08:47 America/Los_Angeles"`,
+		`route_to = "PSVC1"`,
+		`disabled = true`,
+		`expression = "event.source matches part 'db'"`,
+		`expression = "not event.summary matches regex '^test\\'s'"`,
+		`path = "event.source"`,
+		`severity = "info"`,
+		`route_to = "unrouted"`,
+		`# WARNING: rule B1: the router only routes, so annotate must be added to the orchestration of service PSVC1 instead`,
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("output does not contain %q:\n%s", want, out)
+		}
+	}
+
+	if strings.Contains(out, `annotate = "Database"`) {
+		t.Errorf("router rule should not annotate:\n%s", out)
+	}
+
+	if !strings.Contains(stderr.String(), "ruleset R1: rule B1: the router only routes") {
+		t.Errorf("expected a warning on stderr, got %q", stderr.String())
+	}
+}
+
+func TestRunEventOrchestrationService(t *testing.T) {
+	server := testEventOrchestrationServer(t)
+	var stdout, stderr bytes.Buffer
+
+	code := run([]string{"event-orchestration", "-api-url", server.URL, "-service", "S1"}, nil, &stdout, &stderr)
+	if code != 0 {
+		t.Fatalf("exit code %d: %s", code, stderr.String())
+	}
+
+	out := stdout.String()
+	for _, want := range []string{
+		`resource "pagerduty_event_orchestration_service" "My_Web_App" {`,
+		`service = "S1"`,
+		`expression = "not event.custom_details.env matches part 'staging' and now in 2020-01-01 00:00:00 to 2020-02-01 00:00:00 UTC"`,
+		`suppress = true`,
+		`suspend = 300`,
+		`priority = "PPRIO1"`,
+		`target = "event.summary"`,
+		`source = "event.source"`,
+		`# WARNING: rule E1: suppress threshold (4 events in 5 minutes) has no orchestration equivalent`,
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("output does not contain %q:\n%s", want, out)
+		}
+	}
+}
+
+func TestRunEventOrchestrationRequiresOneSource(t *testing.T) {
+	for _, args := range [][]string{
+		{"event-orchestration"},
+		{"event-orchestration", "-ruleset", "R1", "-service", "S1"},
+		{"event-orchestration", "-ruleset", "R1"},
+	} {
+		var stdout, stderr bytes.Buffer
+		if code := run(args, nil, &stdout, &stderr); code != 1 {
+			t.Errorf("%v: expected exit code 1, got %d", args, code)
+		}
+	}
+}
+
+func TestSubconditionExpression(t *testing.T) {
+	for op, want := range map[string]string{
+		"equals":    "event.source matches 'db'",
+		"nequals":   "not event.source matches 'db'",
+		"contains":  "event.source matches part 'db'",
+		"ncontains": "not event.source matches part 'db'",
+		"matches":   "event.source matches regex 'db'",
+		"nmatches":  "not event.source matches regex 'db'",
+		"exists":    "event.source exists",
+		"nexists":   "not event.source exists",
+	} {
+		got, err := subconditionExpression(&pagerduty.RuleSubcondition{
+			Operator:   op,
+			Parameters: &pagerduty.ConditionParameter{Path: "payload.source", Value: "db"},
+		})
+		if err != nil {
+			t.Errorf("%s: %v", op, err)
+		} else if got != want {
+			t.Errorf("%s: expected %q, got %q", op, want, got)
+		}
+	}
+}
+
+func TestRunEventOrchestrationUnknownOperator(t *testing.T) {
+	testEventOrchestrationAPI["/services/S2"] = `{"service": {"id": "S2", "name": "Unknown"}}`
+	testEventOrchestrationAPI["/services/S2/rules"] = `{"rules": [
+  {"id": "E2", "conditions": {"operator": "and", "subconditions": [
+    {"operator": "nctn", "parameters": {"path": "payload.source", "value": "db"}}
+  ]}, "actions": {"suppress": {"value": true}}}
+]}`
+	t.Cleanup(func() {
+		delete(testEventOrchestrationAPI, "/services/S2")
+		delete(testEventOrchestrationAPI, "/services/S2/rules")
+	})
+
+	server := testEventOrchestrationServer(t)
+	var stdout, stderr bytes.Buffer
+
+	if code := run([]string{"event-orchestration", "-api-url", server.URL, "-service", "S2"}, nil, &stdout, &stderr); code != 1 {
+		t.Fatalf("expected exit code 1, got %d", code)
+	}
+	if !strings.Contains(stderr.String(), `rule E2: unknown subcondition operator "nctn"`) {
+		t.Errorf("expected an unknown operator error, got %q", stderr.String())
+	}
+	if stdout.Len() != 0 {
+		t.Errorf("expected no output, got:\n%s", stdout.String())
+	}
+}
+
+func TestConvertRuleConditionsTimeFrame(t *testing.T) {
+	conditions := &pagerduty.RuleConditions{Operator: "and", RuleSubconditions: []*pagerduty.RuleSubcondition{
+		{Operator: "contains", Parameters: &pagerduty.ConditionParameter{Path: "payload.source", Value: "db"}},
+	}}
+	weekly := func(timezone string, start, duration int) *pagerduty.RuleTimeFrame {
+		return &pagerduty.RuleTimeFrame{ScheduledWeekly: &pagerduty.ScheduledWeekly{
+			Weekdays: []int{1, 2}, Timezone: timezone, StartTime: start, Duration: duration,
+		}}
+	}
+	hour := int(time.Hour / time.Millisecond)
+
+	cases := []struct {
+		name      string
+		timeFrame *pagerduty.RuleTimeFrame
+		want      string
+		disable   bool
+	}{
+		{"converted", weekly("UTC", 9*hour, hour), "event.source matches part 'db' and now in Mon,Tue 09:00:00 to 10:00:00 UTC", false},
+		{"crossing midnight", weekly("UTC", 23*hour, 2*hour), "event.source matches part 'db'", true},
+		{"unknown time zone", weekly("Mars/Olympus_Mons", 9*hour, hour), "event.source matches part 'db'", true},
+		{"invalid weekday", &pagerduty.RuleTimeFrame{ScheduledWeekly: &pagerduty.ScheduledWeekly{Weekdays: []int{9}, Timezone: "UTC", Duration: hour}}, "event.source matches part 'db'", true},
+	}
+
+	for _, c := range cases {
+		conds, disable, warnings, err := convertRuleConditions(conditions, c.timeFrame)
+		if err != nil {
+			t.Fatalf("%s: %v", c.name, err)
+		}
+		if len(conds) != 1 || conds[0].Expression != c.want {
+			t.Errorf("%s: unexpected conditions %v", c.name, conds)
+		}
+		if disable != c.disable || (len(warnings) != 0) != c.disable {
+			t.Errorf("%s: expected the rule to be disabled %t, got %t with warnings %v", c.name, c.disable, disable, warnings)
+		}
+	}
+}
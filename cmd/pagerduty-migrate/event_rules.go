@@ -0,0 +1,241 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/heimweh/go-pagerduty/pagerduty"
+	"github.com/terraform-providers/terraform-provider-pagerduty/internal/eventrule"
+)
+
+// stateModule is the subset of `terraform show -json` output we need.
+type stateModule struct {
+	Address      string          `json:"address"`
+	Resources    []stateResource `json:"resources"`
+	ChildModules []stateModule   `json:"child_modules"`
+}
+
+type stateResource struct {
+	Address string                 `json:"address"`
+	Mode    string                 `json:"mode"`
+	Type    string                 `json:"type"`
+	Name    string                 `json:"name"`
+	Values  map[string]interface{} `json:"values"`
+}
+
+type stateDocument struct {
+	Values struct {
+		RootModule stateModule `json:"root_module"`
+	} `json:"values"`
+}
+
+func runEventRules(args []string, stdin io.Reader, stdout, stderr io.Writer) error {
+	fs := flag.NewFlagSet("event-rules", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	ruleset := fs.String("ruleset", "", "ID of, or reference to, the ruleset the rules are created in (required)")
+	file := fs.String("state", "-", "path to `terraform show -json` output, - for stdin")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	if *ruleset == "" {
+		return fmt.Errorf("-ruleset is required")
+	}
+
+	in := stdin
+	if *file != "-" {
+		f, err := os.Open(*file)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		in = f
+	}
+
+	var doc stateDocument
+	if err := json.NewDecoder(in).Decode(&doc); err != nil {
+		return fmt.Errorf("reading state: %v", err)
+	}
+
+	out, warnings, err := convertEventRules(&doc.Values.RootModule, referenceExpr(*ruleset))
+	if err != nil {
+		return err
+	}
+
+	for _, w := range warnings {
+		fmt.Fprintf(stderr, "Warning: %s\n", w)
+	}
+
+	_, err = io.WriteString(stdout, out)
+	return err
+}
+
+// referenceExpr quotes plain IDs and leaves references such as
+// pagerduty_ruleset.global.id untouched.
+func referenceExpr(v string) interface{} {
+	if strings.Contains(v, ".") {
+		return expr(v)
+	}
+	return v
+}
+
+func collectEventRules(m *stateModule, rules []stateResource) []stateResource {
+	for _, r := range m.Resources {
+		if r.Mode == "managed" && r.Type == "pagerduty_event_rule" {
+			rules = append(rules, r)
+		}
+	}
+	for i := range m.ChildModules {
+		rules = collectEventRules(&m.ChildModules[i], rules)
+	}
+	return rules
+}
+
+// resourceName derives a resource name from the address of r, so that
+// module.team_a.pagerduty_event_rule.foo["x"] becomes team_a_foo_x.
+func resourceName(r stateResource) string {
+	name := strings.ReplaceAll(r.Address, "module.", "")
+	name = strings.ReplaceAll(name, r.Type+".", "")
+	return labelName(name)
+}
+
+// labelName turns s into a resource name without repeated, leading or
+// trailing underscores. The underscore in front of a leading digit is kept,
+// as identifiers can't start with one.
+func labelName(s string) string {
+	name := hclIdentifier(s)
+	for strings.Contains(name, "__") {
+		name = strings.ReplaceAll(name, "__", "_")
+	}
+	name = strings.TrimRight(name, "_")
+	if len(name) > 1 && name[0] == '_' && !(name[1] >= '0' && name[1] <= '9' || name[1] == '-') {
+		name = name[1:]
+	}
+	return name
+}
+
+func convertEventRules(root *stateModule, ruleset interface{}) (string, []string, error) {
+	resources := collectEventRules(root, nil)
+	if len(resources) == 0 {
+		return "", nil, fmt.Errorf("no pagerduty_event_rule resources found in state")
+	}
+
+	w := new(hclWriter)
+	var warnings []string
+
+	for i, r := range resources {
+		action, _ := r.Values["action_json"].(string)
+		condition, _ := r.Values["condition_json"].(string)
+		advanced, _ := r.Values["advanced_condition_json"].(string)
+
+		rule, err := eventrule.Parse(action, condition, advanced)
+		if err != nil {
+			return "", nil, fmt.Errorf("%s: %v", r.Address, err)
+		}
+
+		name := resourceName(r)
+
+		if i > 0 {
+			w.blank()
+		}
+		w.comment("Converted from %s", r.Address)
+		for _, u := range rule.Unsupported {
+			w.comment("WARNING: %s", u)
+			warnings = append(warnings, fmt.Sprintf("%s: %s", r.Address, u))
+		}
+		if catchAll, _ := r.Values["catch_all"].(bool); catchAll {
+			w.comment("WARNING: this was the catch-all rule; configure the ruleset's catch-all rule instead")
+			warnings = append(warnings, fmt.Sprintf("%s: catch-all rules are not converted", r.Address))
+		}
+
+		writeRulesetRule(w, name, ruleset, i, rule)
+	}
+
+	return w.String(), warnings, nil
+}
+
+func writeRulesetRule(w *hclWriter, name string, ruleset interface{}, position int, rule *eventrule.Rule) {
+	w.open("resource", "pagerduty_ruleset_rule", name)
+	w.attr("ruleset", ruleset)
+	w.attr("position", position)
+
+	w.open("conditions")
+	w.attr("operator", rule.Conditions.Operator)
+	for _, sc := range rule.Conditions.RuleSubconditions {
+		w.open("subconditions")
+		w.attr("operator", sc.Operator)
+		w.open("parameter")
+		w.attr("path", sc.Parameters.Path)
+		if sc.Parameters.Value != "" {
+			w.attr("value", sc.Parameters.Value)
+		}
+		w.close()
+		w.close()
+	}
+	w.close()
+
+	if tf := rule.TimeFrame; tf != nil {
+		w.open("time_frame")
+		if sw := tf.ScheduledWeekly; sw != nil {
+			w.open("scheduled_weekly")
+			w.attr("timezone", sw.Timezone)
+			w.attr("start_time", sw.StartTime)
+			w.attr("duration", sw.Duration)
+			w.attr("weekdays", sw.Weekdays)
+			w.close()
+		}
+		if ab := tf.ActiveBetween; ab != nil {
+			w.open("active_between")
+			w.attr("start_time", ab.StartTime)
+			w.attr("end_time", ab.EndTime)
+			w.close()
+		}
+		w.close()
+	}
+
+	writeRulesetRuleActions(w, rule.Actions)
+
+	w.close()
+}
+
+func writeRulesetRuleActions(w *hclWriter, a *pagerduty.RuleActions) {
+	w.open("actions")
+	for _, p := range []struct {
+		name  string
+		param *pagerduty.RuleActionParameter
+	}{
+		{"route", a.Route},
+		{"severity", a.Severity},
+		{"priority", a.Priority},
+		{"annotate", a.Annotate},
+		{"event_action", a.EventAction},
+	} {
+		if p.param != nil {
+			w.open(p.name)
+			w.attr("value", p.param.Value)
+			w.close()
+		}
+	}
+	if a.Suppress != nil {
+		w.open("suppress")
+		w.attr("value", a.Suppress.Value)
+		w.close()
+	}
+	if a.Suspend != nil {
+		w.open("suspend")
+		w.attr("value", a.Suspend.Value)
+		w.close()
+	}
+	for _, e := range a.Extractions {
+		w.open("extractions")
+		w.attr("target", e.Target)
+		w.attr("source", e.Source)
+		w.attr("regex", e.Regex)
+		w.close()
+	}
+	w.close()
+}
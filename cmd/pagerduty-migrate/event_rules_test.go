@@ -0,0 +1,99 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+const testEventRulesState = `{
+  "values": {
+    "root_module": {
+      "resources": [
+        {
+          "address": "pagerduty_event_rule.second",
+          "mode": "managed",
+          "type": "pagerduty_event_rule",
+          "name": "second",
+          "values": {
+            "action_json": "[[\"route\",\"P5DTL0K\"],[\"severity\",\"warning\"],[\"annotate\",\"2 Managed by ${terraform}\"]]",
+            "condition_json": "[\"and\",[\"contains\",[\"path\",\"payload\",\"source\"],\"website\"]]",
+            "advanced_condition_json": "[[\"scheduled-weekly\",1565392127032,3600000,\"America/Los_Angeles\",[1,2]],[\"frequency-over\",60000,10]]",
+            "catch_all": false
+          }
+        }
+      ],
+      "child_modules": [
+        {
+          "address": "module.team_a",
+          "resources": [
+            {
+              "address": "module.team_a.pagerduty_event_rule.third",
+              "mode": "managed",
+              "type": "pagerduty_event_rule",
+              "name": "third",
+              "values": {
+                "action_json": "[[\"suppress\",true]]",
+                "condition_json": "[\"or\",[\"not\",[\"matches\",[\"path\",\"payload\",\"summary\"],\"^test\"]]]"
+              }
+            }
+          ]
+        }
+      ]
+    }
+  }
+}`
+
+func TestRunEventRules(t *testing.T) {
+	var stdout, stderr bytes.Buffer
+
+	code := run([]string{"event-rules", "-ruleset", "pagerduty_ruleset.global.id"}, strings.NewReader(testEventRulesState), &stdout, &stderr)
+	if code != 0 {
+		t.Fatalf("exit code %d: %s", code, stderr.String())
+	}
+
+	out := stdout.String()
+	for _, want := range []string{
+		`resource "pagerduty_ruleset_rule" "second" {`,
+		`resource "pagerduty_ruleset_rule" "team_a_third" {`,
+		`ruleset = pagerduty_ruleset.global.id`,
+		`path = "payload.source"`,
+		`operator = "nmatches"`,
+		`weekdays = [1, 2]`,
+		`value = "2 Managed by $${terraform}"`,
+		`# WARNING: advanced condition 1: frequency-over has no ruleset rule equivalent`,
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("output does not contain %q:\n%s", want, out)
+		}
+	}
+
+	if !strings.Contains(stderr.String(), "pagerduty_event_rule.second: advanced condition 1") {
+		t.Errorf("expected a warning on stderr, got %q", stderr.String())
+	}
+}
+
+func TestRunEventRulesRequiresRuleset(t *testing.T) {
+	var stdout, stderr bytes.Buffer
+
+	if code := run([]string{"event-rules"}, strings.NewReader(testEventRulesState), &stdout, &stderr); code != 1 {
+		t.Fatalf("expected exit code 1, got %d", code)
+	}
+}
+
+func TestLabelName(t *testing.T) {
+	cases := map[string]string{
+		"team_a.foo[\"x\"]": "team_a_foo_x",
+		"Default Ruleset":   "Default_Ruleset",
+		"__foo__":           "foo",
+		"1st":               "_1st",
+		"1st rule!":         "_1st_rule",
+		"-1":                "_-1",
+	}
+
+	for s, want := range cases {
+		if got := labelName(s); got != want {
+			t.Errorf("labelName(%q): expected %q, got %q", s, want, got)
+		}
+	}
+}
@@ -0,0 +1,127 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+)
+
+// expr is written to HCL verbatim, e.g. a reference to another resource.
+type expr string
+
+// hclWriter builds HCL documents block by block. The output is valid HCL
+// but is not aligned; run `terraform fmt` on it afterwards.
+type hclWriter struct {
+	buf    bytes.Buffer
+	indent int
+}
+
+func (w *hclWriter) line(format string, a ...interface{}) {
+	w.buf.WriteString(strings.Repeat("  ", w.indent))
+	fmt.Fprintf(&w.buf, format, a...)
+	w.buf.WriteString("\n")
+}
+
+func (w *hclWriter) comment(format string, a ...interface{}) {
+	w.line("# "+format, a...)
+}
+
+func (w *hclWriter) blank() {
+	w.buf.WriteString("\n")
+}
+
+func (w *hclWriter) open(name string, labels ...string) {
+	parts := []string{name}
+	for _, l := range labels {
+		parts = append(parts, hclString(l))
+	}
+	w.line("%s {", strings.Join(parts, " "))
+	w.indent++
+}
+
+func (w *hclWriter) close() {
+	w.indent--
+	w.line("}")
+}
+
+func (w *hclWriter) attr(name string, value interface{}) {
+	w.line("%s = %s", name, hclValue(value))
+}
+
+func (w *hclWriter) String() string {
+	return w.buf.String()
+}
+
+func hclValue(v interface{}) string {
+	switch v := v.(type) {
+	case expr:
+		return string(v)
+	case string:
+		return hclString(v)
+	case []string:
+		items := make([]string, len(v))
+		for i, s := range v {
+			items[i] = hclString(s)
+		}
+		return "[" + strings.Join(items, ", ") + "]"
+	case []int:
+		items := make([]string, len(v))
+		for i, n := range v {
+			items[i] = fmt.Sprintf("%d", n)
+		}
+		return "[" + strings.Join(items, ", ") + "]"
+	default:
+		return fmt.Sprintf("%v", v)
+	}
+}
+
+// hclString quotes s as an HCL string literal, escaping template sequences.
+func hclString(s string) string {
+	var b strings.Builder
+	b.WriteByte('"')
+	for i, r := range s {
+		switch r {
+		case '"':
+			b.WriteString(`\"`)
+		case '\\':
+			b.WriteString(`\\`)
+		case '\n':
+			b.WriteString(`\n`)
+		case '\r':
+			b.WriteString(`\r`)
+		case '\t':
+			b.WriteString(`\t`)
+		case '$', '%':
+			b.WriteRune(r)
+			if i+1 < len(s) && s[i+1] == '{' {
+				b.WriteRune(r)
+			}
+		default:
+			b.WriteRune(r)
+		}
+	}
+	b.WriteByte('"')
+	return b.String()
+}
+
+// hclIdentifier turns s into a valid resource name.
+func hclIdentifier(s string) string {
+	var b strings.Builder
+	for i, r := range s {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r == '_':
+			b.WriteRune(r)
+		case (r >= '0' && r <= '9') || r == '-':
+			if i == 0 {
+				b.WriteRune('_')
+			}
+			b.WriteRune(r)
+		default:
+			b.WriteRune('_')
+		}
+	}
+	if b.Len() == 0 {
+		return "_"
+	}
+	return b.String()
+}
@@ -0,0 +1,67 @@
+// Command pagerduty-migrate generates HCL that replaces deprecated PagerDuty
+// resources with their supported equivalents.
+//
+// Usage:
+//
+//	terraform show -json | pagerduty-migrate event-rules -ruleset pagerduty_ruleset.global.id
+//	pagerduty-migrate event-orchestration -ruleset P5DTL0K -event-orchestration pagerduty_event_orchestration.global.id
+//	pagerduty-migrate event-orchestration -service PIJ90N7
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+)
+
+type command struct {
+	name  string
+	usage string
+	run   func(args []string, stdin io.Reader, stdout, stderr io.Writer) error
+}
+
+var commands = []command{
+	{
+		name:  "event-rules",
+		usage: "convert pagerduty_event_rule resources from `terraform show -json` output into pagerduty_ruleset_rule HCL",
+		run:   runEventRules,
+	},
+	{
+		name:  "event-orchestration",
+		usage: "convert the rules of a ruleset or service, read from the API using PAGERDUTY_TOKEN, into event orchestration HCL",
+		run:   runEventOrchestration,
+	},
+}
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdin, os.Stdout, os.Stderr))
+}
+
+func run(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	if len(args) == 0 {
+		usage(stderr)
+		return 2
+	}
+
+	for _, c := range commands {
+		if c.name == args[0] {
+			if err := c.run(args[1:], stdin, stdout, stderr); err != nil {
+				fmt.Fprintf(stderr, "Error: %s\n", err)
+				return 1
+			}
+			return 0
+		}
+	}
+
+	usage(stderr)
+	return 2
+}
+
+func usage(w io.Writer) {
+	fmt.Fprintln(w, "Usage: pagerduty-migrate <command> [flags]")
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "Commands:")
+	for _, c := range commands {
+		fmt.Fprintf(w, "  %-20s %s\n", c.name, c.usage)
+	}
+}
@@ -0,0 +1,421 @@
+// Package eventrule parses the JSON encoded rules used by the deprecated
+// global event rules API into the equivalent ruleset rule structures.
+package eventrule
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/heimweh/go-pagerduty/pagerduty"
+)
+
+// Rule is the parsed form of a global event rule.
+type Rule struct {
+	Conditions *pagerduty.RuleConditions
+	Actions    *pagerduty.RuleActions
+	TimeFrame  *pagerduty.RuleTimeFrame
+
+	// Unsupported lists constructs that are valid for global event rules
+	// but have no ruleset rule equivalent.
+	Unsupported []string
+}
+
+// ConditionOperators maps the operators of global event rule conditions to
+// the subcondition operators of ruleset rules.
+var ConditionOperators = map[string]string{
+	"contains": "contains",
+	"equals":   "equals",
+	"exists":   "exists",
+	"matches":  "matches",
+}
+
+// NegatedConditionOperators maps the operators of global event rule
+// conditions wrapped in "not" to the subcondition operators of ruleset rules.
+var NegatedConditionOperators = map[string]string{
+	"contains": "ncontains",
+	"equals":   "nequals",
+	"exists":   "nexists",
+	"matches":  "nmatches",
+}
+
+// SubconditionOperator returns the global event rule operator of a ruleset
+// rule subcondition operator, and whether the subcondition negates it.
+func SubconditionOperator(op string) (base string, negated bool, ok bool) {
+	for base, rop := range ConditionOperators {
+		if rop == op {
+			return base, false, true
+		}
+	}
+	for base, rop := range NegatedConditionOperators {
+		if rop == op {
+			return base, true, true
+		}
+	}
+	return "", false, false
+}
+
+// Parse parses the action, condition and (optional) advanced condition JSON
+// of a global event rule.
+func Parse(actions, condition, advancedCondition string) (*Rule, error) {
+	r := new(Rule)
+	var err error
+
+	if r.Conditions, err = ParseCondition(condition); err != nil {
+		return nil, fmt.Errorf("condition_json: %v", err)
+	}
+
+	if r.Actions, err = ParseActions(actions); err != nil {
+		return nil, fmt.Errorf("action_json: %v", err)
+	}
+
+	if advancedCondition != "" {
+		if r.TimeFrame, r.Unsupported, err = ParseAdvancedCondition(advancedCondition); err != nil {
+			return nil, fmt.Errorf("advanced_condition_json: %v", err)
+		}
+	}
+
+	return r, nil
+}
+
+// ParseCondition parses a condition such as
+// ["and", ["contains", ["path", "payload", "source"], "website"]].
+func ParseCondition(v string) (*pagerduty.RuleConditions, error) {
+	var raw []interface{}
+	if err := json.Unmarshal([]byte(v), &raw); err != nil {
+		return nil, fmt.Errorf("must be a JSON list: %v", err)
+	}
+
+	if len(raw) < 2 {
+		return nil, fmt.Errorf("must contain an operator followed by at least one condition")
+	}
+
+	op, ok := raw[0].(string)
+	if !ok || (op != "and" && op != "or") {
+		return nil, fmt.Errorf("the first element must be %q or %q, got %v", "and", "or", raw[0])
+	}
+
+	conditions := &pagerduty.RuleConditions{Operator: op}
+
+	for i, c := range raw[1:] {
+		sc, err := parseSubcondition(c)
+		if err != nil {
+			return nil, fmt.Errorf("condition %d: %v", i+1, err)
+		}
+		conditions.RuleSubconditions = append(conditions.RuleSubconditions, sc)
+	}
+
+	return conditions, nil
+}
+
+func parseSubcondition(v interface{}) (*pagerduty.RuleSubcondition, error) {
+	c, ok := v.([]interface{})
+	if !ok || len(c) == 0 {
+		return nil, fmt.Errorf("must be a non-empty list, got %v", v)
+	}
+
+	op, ok := c[0].(string)
+	if !ok {
+		return nil, fmt.Errorf("operator must be a string, got %v", c[0])
+	}
+
+	operators := ConditionOperators
+	if op == "not" {
+		if len(c) != 2 {
+			return nil, fmt.Errorf("%q must wrap exactly one condition", "not")
+		}
+		if c, ok = c[1].([]interface{}); !ok || len(c) == 0 {
+			return nil, fmt.Errorf("%q must wrap a non-empty list", "not")
+		}
+		if op, ok = c[0].(string); !ok {
+			return nil, fmt.Errorf("operator must be a string, got %v", c[0])
+		}
+		operators = NegatedConditionOperators
+	}
+
+	rop, ok := operators[op]
+	if !ok {
+		return nil, fmt.Errorf("unknown operator %q", op)
+	}
+
+	want := 3
+	if op == "exists" {
+		want = 2
+	}
+	if len(c) != want {
+		return nil, fmt.Errorf("%q expects %d arguments, got %d", op, want-1, len(c)-1)
+	}
+
+	path, err := parsePath(c[1])
+	if err != nil {
+		return nil, err
+	}
+
+	param := &pagerduty.ConditionParameter{Path: path}
+	if want == 3 {
+		if param.Value, ok = c[2].(string); !ok {
+			return nil, fmt.Errorf("%q value must be a string, got %v", op, c[2])
+		}
+	}
+
+	return &pagerduty.RuleSubcondition{Operator: rop, Parameters: param}, nil
+}
+
+// parsePath turns ["path", "payload", "source"] into "payload.source".
+func parsePath(v interface{}) (string, error) {
+	p, ok := v.([]interface{})
+	if !ok || len(p) < 2 || p[0] != "path" {
+		return "", fmt.Errorf("path must be a list starting with %q, got %v", "path", v)
+	}
+
+	var segments []string
+	for _, s := range p[1:] {
+		switch s := s.(type) {
+		case string:
+			segments = append(segments, s)
+		case float64:
+			segments = append(segments, fmt.Sprintf("%d", int(s)))
+		default:
+			return "", fmt.Errorf("path segments must be strings or numbers, got %v", s)
+		}
+	}
+
+	return strings.Join(segments, "."), nil
+}
+
+// ParseActions parses a list of actions such as
+// [["route", "P5DTL0K"], ["severity", "warning"]].
+func ParseActions(v string) (*pagerduty.RuleActions, error) {
+	var raw [][]interface{}
+	if err := json.Unmarshal([]byte(v), &raw); err != nil {
+		return nil, fmt.Errorf("must be a JSON list of lists: %v", err)
+	}
+
+	if len(raw) == 0 {
+		return nil, fmt.Errorf("must contain at least one action")
+	}
+
+	actions := new(pagerduty.RuleActions)
+
+	for i, a := range raw {
+		if err := parseAction(actions, a); err != nil {
+			return nil, fmt.Errorf("action %d: %v", i, err)
+		}
+	}
+
+	return actions, nil
+}
+
+func parseAction(actions *pagerduty.RuleActions, a []interface{}) error {
+	if len(a) == 0 {
+		return fmt.Errorf("must not be empty")
+	}
+
+	name, ok := a[0].(string)
+	if !ok {
+		return fmt.Errorf("action name must be a string, got %v", a[0])
+	}
+
+	args := a[1:]
+
+	switch name {
+	case "route", "priority", "annotate":
+		s, err := stringArg(name, args)
+		if err != nil {
+			return err
+		}
+		p := &pagerduty.RuleActionParameter{Value: s}
+		switch name {
+		case "route":
+			actions.Route = p
+		case "priority":
+			actions.Priority = p
+		default:
+			actions.Annotate = p
+		}
+	case "severity":
+		s, err := stringArg(name, args)
+		if err != nil {
+			return err
+		}
+		if err := oneOf(name, s, "info", "warning", "error", "critical"); err != nil {
+			return err
+		}
+		actions.Severity = &pagerduty.RuleActionParameter{Value: s}
+	case "event_action":
+		s, err := stringArg(name, args)
+		if err != nil {
+			return err
+		}
+		if err := oneOf(name, s, "trigger", "resolve"); err != nil {
+			return err
+		}
+		actions.EventAction = &pagerduty.RuleActionParameter{Value: s}
+	case "suppress":
+		if len(args) != 1 {
+			return fmt.Errorf("%q expects 1 argument, got %d", name, len(args))
+		}
+		b, ok := args[0].(bool)
+		if !ok {
+			return fmt.Errorf("%q expects a boolean, got %v", name, args[0])
+		}
+		actions.Suppress = &pagerduty.RuleActionSuppress{Value: b}
+	case "suspend":
+		if len(args) != 1 {
+			return fmt.Errorf("%q expects 1 argument, got %d", name, len(args))
+		}
+		n, ok := args[0].(float64)
+		if !ok || n < 0 {
+			return fmt.Errorf("%q expects a non-negative number of seconds, got %v", name, args[0])
+		}
+		actions.Suspend = &pagerduty.RuleActionIntParameter{Value: int(n)}
+	case "extract":
+		if len(args) != 3 {
+			return fmt.Errorf("%q expects a target, a source path and a regex, got %d arguments", name, len(args))
+		}
+		target, ok := args[0].(string)
+		if !ok {
+			return fmt.Errorf("%q target must be a string, got %v", name, args[0])
+		}
+		source, err := parsePath(args[1])
+		if err != nil {
+			return fmt.Errorf("%q source: %v", name, err)
+		}
+		regex, ok := args[2].(string)
+		if !ok {
+			return fmt.Errorf("%q regex must be a string, got %v", name, args[2])
+		}
+		actions.Extractions = append(actions.Extractions, &pagerduty.RuleActionExtraction{
+			Target: target,
+			Source: source,
+			Regex:  regex,
+		})
+	default:
+		return fmt.Errorf("unknown action %q", name)
+	}
+
+	return nil
+}
+
+func stringArg(name string, args []interface{}) (string, error) {
+	if len(args) != 1 {
+		return "", fmt.Errorf("%q expects 1 argument, got %d", name, len(args))
+	}
+	s, ok := args[0].(string)
+	if !ok {
+		return "", fmt.Errorf("%q expects a string, got %v", name, args[0])
+	}
+	return s, nil
+}
+
+func oneOf(name, value string, values ...string) error {
+	for _, v := range values {
+		if value == v {
+			return nil
+		}
+	}
+	return fmt.Errorf("%q must be one of %v, got %q", name, values, value)
+}
+
+// ParseAdvancedCondition parses a list of advanced conditions such as
+// [["scheduled-weekly", 1565392127032, 3600000, "America/Los_Angeles", [1, 3]]].
+// Conditions that are valid but cannot be expressed as a ruleset rule time
+// frame (frequency-over) are returned as the second value.
+func ParseAdvancedCondition(v string) (*pagerduty.RuleTimeFrame, []string, error) {
+	var raw [][]interface{}
+	if err := json.Unmarshal([]byte(v), &raw); err != nil {
+		return nil, nil, fmt.Errorf("must be a JSON list of lists: %v", err)
+	}
+
+	var timeFrame *pagerduty.RuleTimeFrame
+	var unsupported []string
+
+	for i, c := range raw {
+		if len(c) == 0 {
+			return nil, nil, fmt.Errorf("condition %d: must not be empty", i)
+		}
+
+		name, _ := c[0].(string)
+		switch name {
+		case "scheduled-weekly":
+			sw, err := parseScheduledWeekly(c[1:])
+			if err != nil {
+				return nil, nil, fmt.Errorf("condition %d: %v", i, err)
+			}
+			if timeFrame == nil {
+				timeFrame = new(pagerduty.RuleTimeFrame)
+			}
+			timeFrame.ScheduledWeekly = sw
+		case "active-between":
+			ab, err := parseActiveBetween(c[1:])
+			if err != nil {
+				return nil, nil, fmt.Errorf("condition %d: %v", i, err)
+			}
+			if timeFrame == nil {
+				timeFrame = new(pagerduty.RuleTimeFrame)
+			}
+			timeFrame.ActiveBetween = ab
+		case "frequency-over":
+			unsupported = append(unsupported, fmt.Sprintf("advanced condition %d: frequency-over has no ruleset rule equivalent", i))
+		default:
+			return nil, nil, fmt.Errorf("condition %d: unknown advanced condition %v", i, c[0])
+		}
+	}
+
+	return timeFrame, unsupported, nil
+}
+
+func parseScheduledWeekly(args []interface{}) (*pagerduty.ScheduledWeekly, error) {
+	if len(args) != 4 {
+		return nil, fmt.Errorf("scheduled-weekly expects a start time, a duration, a time zone and a list of weekdays, got %d arguments", len(args))
+	}
+
+	start, ok := args[0].(float64)
+	if !ok {
+		return nil, fmt.Errorf("scheduled-weekly start time must be a number, got %v", args[0])
+	}
+	duration, ok := args[1].(float64)
+	if !ok || duration <= 0 {
+		return nil, fmt.Errorf("scheduled-weekly duration must be a positive number, got %v", args[1])
+	}
+	tz, ok := args[2].(string)
+	if !ok || tz == "" {
+		return nil, fmt.Errorf("scheduled-weekly time zone must be a string, got %v", args[2])
+	}
+	days, ok := args[3].([]interface{})
+	if !ok || len(days) == 0 {
+		return nil, fmt.Errorf("scheduled-weekly weekdays must be a non-empty list, got %v", args[3])
+	}
+
+	sw := &pagerduty.ScheduledWeekly{
+		StartTime: int(start),
+		Duration:  int(duration),
+		Timezone:  tz,
+	}
+	for _, d := range days {
+		n, ok := d.(float64)
+		if !ok || n < 1 || n > 7 {
+			return nil, fmt.Errorf("scheduled-weekly weekdays must be numbers between 1 and 7, got %v", d)
+		}
+		sw.Weekdays = append(sw.Weekdays, int(n))
+	}
+
+	return sw, nil
+}
+
+func parseActiveBetween(args []interface{}) (*pagerduty.ActiveBetween, error) {
+	if len(args) != 2 {
+		return nil, fmt.Errorf("active-between expects a start and an end time, got %d arguments", len(args))
+	}
+
+	start, ok := args[0].(float64)
+	if !ok {
+		return nil, fmt.Errorf("active-between start time must be a number, got %v", args[0])
+	}
+	end, ok := args[1].(float64)
+	if !ok || end <= start {
+		return nil, fmt.Errorf("active-between end time must be a number after the start time, got %v", args[1])
+	}
+
+	return &pagerduty.ActiveBetween{StartTime: int(start), EndTime: int(end)}, nil
+}
@@ -0,0 +1,164 @@
+package eventrule
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/heimweh/go-pagerduty/pagerduty"
+)
+
+func TestParseCondition(t *testing.T) {
+	got, err := ParseCondition(`["and",
+		["contains", ["path", "payload", "source"], "website"],
+		["not", ["exists", ["path", "headers", "from", 0, "address"]]],
+		["not", ["contains", ["path", "payload", "summary"], "test"]]
+	]`)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := &pagerduty.RuleConditions{
+		Operator: "and",
+		RuleSubconditions: []*pagerduty.RuleSubcondition{
+			{Operator: "contains", Parameters: &pagerduty.ConditionParameter{Path: "payload.source", Value: "website"}},
+			{Operator: "nexists", Parameters: &pagerduty.ConditionParameter{Path: "headers.from.0.address"}},
+			{Operator: "ncontains", Parameters: &pagerduty.ConditionParameter{Path: "payload.summary", Value: "test"}},
+		},
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %#v, want %#v", got, want)
+	}
+}
+
+func TestSubconditionOperator(t *testing.T) {
+	cases := map[string]struct {
+		base    string
+		negated bool
+		ok      bool
+	}{
+		"contains":  {"contains", false, true},
+		"ncontains": {"contains", true, true},
+		"nequals":   {"equals", true, true},
+		"nexists":   {"exists", true, true},
+		"matches":   {"matches", false, true},
+		"nctains":   {"", false, false},
+	}
+
+	for op, want := range cases {
+		base, negated, ok := SubconditionOperator(op)
+		if base != want.base || negated != want.negated || ok != want.ok {
+			t.Errorf("SubconditionOperator(%q) = %q, %v, %v, want %q, %v, %v", op, base, negated, ok, want.base, want.negated, want.ok)
+		}
+	}
+}
+
+func TestParseConditionInvalid(t *testing.T) {
+	cases := map[string]string{
+		`{"and": []}`:                        "must be a JSON list",
+		`["and"]`:                            "at least one condition",
+		`["xor", ["exists", ["path", "a"]]]`: "first element",
+		`["and", ["startswith", ["path", "a"], "b"]]`: "unknown operator",
+		`["and", ["contains", ["payload"], "b"]]`:     "path must be a list",
+		`["and", ["exists", ["path", "a"], "b"]]`:     "expects 1 arguments",
+		`["and", ["equals", ["path", "a"], 1]]`:       "must be a string",
+	}
+
+	for in, want := range cases {
+		if _, err := ParseCondition(in); err == nil || !strings.Contains(err.Error(), want) {
+			t.Errorf("ParseCondition(%s): got error %v, want it to contain %q", in, err, want)
+		}
+	}
+}
+
+func TestParseActions(t *testing.T) {
+	got, err := ParseActions(`[
+		["route", "P5DTL0K"],
+		["severity", "warning"],
+		["annotate", "Managed by terraform"],
+		["priority", "PL451DT"],
+		["suppress", false],
+		["suspend", 300],
+		["extract", "summary", ["path", "payload", "source"], "(.*)"]
+	]`)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := &pagerduty.RuleActions{
+		Route:       &pagerduty.RuleActionParameter{Value: "P5DTL0K"},
+		Severity:    &pagerduty.RuleActionParameter{Value: "warning"},
+		Annotate:    &pagerduty.RuleActionParameter{Value: "Managed by terraform"},
+		Priority:    &pagerduty.RuleActionParameter{Value: "PL451DT"},
+		Suppress:    &pagerduty.RuleActionSuppress{Value: false},
+		Suspend:     &pagerduty.RuleActionIntParameter{Value: 300},
+		Extractions: []*pagerduty.RuleActionExtraction{{Target: "summary", Source: "payload.source", Regex: "(.*)"}},
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %#v, want %#v", got, want)
+	}
+}
+
+func TestParseActionsInvalid(t *testing.T) {
+	cases := map[string]string{
+		`["route", "P5DTL0K"]`:        "list of lists",
+		`[]`:                          "at least one action",
+		`[["reroute", "P5DTL0K"]]`:    "unknown action",
+		`[["severity", "urgent"]]`:    "must be one of",
+		`[["route"]]`:                 "expects 1 argument",
+		`[["suppress", "yes"]]`:       "expects a boolean",
+		`[["extract", "summary", 1]]`: "expects a target",
+	}
+
+	for in, want := range cases {
+		if _, err := ParseActions(in); err == nil || !strings.Contains(err.Error(), want) {
+			t.Errorf("ParseActions(%s): got error %v, want it to contain %q", in, err, want)
+		}
+	}
+}
+
+func TestParseAdvancedCondition(t *testing.T) {
+	tf, unsupported, err := ParseAdvancedCondition(`[
+		["scheduled-weekly", 1565392127032, 3600000, "America/Los_Angeles", [1, 3, 5, 7]],
+		["active-between", 1565392127032, 1565395727032],
+		["frequency-over", 60000, 10]
+	]`)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := &pagerduty.RuleTimeFrame{
+		ScheduledWeekly: &pagerduty.ScheduledWeekly{
+			StartTime: 1565392127032,
+			Duration:  3600000,
+			Timezone:  "America/Los_Angeles",
+			Weekdays:  []int{1, 3, 5, 7},
+		},
+		ActiveBetween: &pagerduty.ActiveBetween{StartTime: 1565392127032, EndTime: 1565395727032},
+	}
+
+	if !reflect.DeepEqual(tf, want) {
+		t.Errorf("got %#v, want %#v", tf, want)
+	}
+
+	if len(unsupported) != 1 || !strings.Contains(unsupported[0], "frequency-over") {
+		t.Errorf("expected frequency-over to be reported as unsupported, got %v", unsupported)
+	}
+}
+
+func TestParseAdvancedConditionInvalid(t *testing.T) {
+	cases := map[string]string{
+		`[["scheduled-weekly", 1, 3600000, "UTC", [8]]]`: "between 1 and 7",
+		`[["scheduled-weekly", 1, 3600000, "UTC"]]`:      "expects a start time",
+		`[["active-between", 10, 5]]`:                    "after the start time",
+		`[["sometimes"]]`:                                "unknown advanced condition",
+	}
+
+	for in, want := range cases {
+		if _, _, err := ParseAdvancedCondition(in); err == nil || !strings.Contains(err.Error(), want) {
+			t.Errorf("ParseAdvancedCondition(%s): got error %v, want it to contain %q", in, err, want)
+		}
+	}
+}
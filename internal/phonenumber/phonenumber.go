@@ -0,0 +1,192 @@
+// Package phonenumber validates and normalizes the phone numbers of phone and
+// SMS contact methods, which PagerDuty stores as the national significant
+// number of the E.164 number, i.e. without the country calling code.
+package phonenumber
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// maxDigits is the maximum number of digits of an E.164 number, country
+// calling code included.
+const maxDigits = 15
+
+type country struct {
+	// minLength and maxLength bound the length of the national significant number.
+	minLength int
+	maxLength int
+	// keepLeadingZero is set for the countries where the leading zero is
+	// part of the national significant number instead of a trunk prefix.
+	keepLeadingZero bool
+}
+
+// countries holds the length rules of the most common country calling
+// codes. The other assigned codes only get the E.164 length rules.
+var countries = map[int]country{
+	1:   {minLength: 10, maxLength: 10},
+	7:   {minLength: 10, maxLength: 10},
+	20:  {minLength: 8, maxLength: 10},
+	27:  {minLength: 9, maxLength: 9},
+	30:  {minLength: 10, maxLength: 10},
+	31:  {minLength: 9, maxLength: 9},
+	32:  {minLength: 8, maxLength: 9},
+	33:  {minLength: 9, maxLength: 9},
+	34:  {minLength: 9, maxLength: 9},
+	36:  {minLength: 8, maxLength: 9},
+	39:  {minLength: 6, maxLength: 11, keepLeadingZero: true},
+	40:  {minLength: 9, maxLength: 9},
+	41:  {minLength: 9, maxLength: 9},
+	43:  {minLength: 4, maxLength: 13},
+	44:  {minLength: 9, maxLength: 10},
+	45:  {minLength: 8, maxLength: 8},
+	46:  {minLength: 6, maxLength: 10},
+	47:  {minLength: 8, maxLength: 8},
+	48:  {minLength: 9, maxLength: 9},
+	49:  {minLength: 5, maxLength: 13},
+	51:  {minLength: 8, maxLength: 9},
+	52:  {minLength: 10, maxLength: 10},
+	54:  {minLength: 10, maxLength: 11},
+	55:  {minLength: 10, maxLength: 11},
+	56:  {minLength: 9, maxLength: 9},
+	57:  {minLength: 8, maxLength: 10},
+	58:  {minLength: 10, maxLength: 10},
+	60:  {minLength: 8, maxLength: 10},
+	61:  {minLength: 9, maxLength: 9},
+	62:  {minLength: 8, maxLength: 12},
+	63:  {minLength: 8, maxLength: 10},
+	64:  {minLength: 8, maxLength: 10},
+	65:  {minLength: 8, maxLength: 8},
+	66:  {minLength: 8, maxLength: 9},
+	81:  {minLength: 9, maxLength: 10},
+	82:  {minLength: 8, maxLength: 10},
+	84:  {minLength: 9, maxLength: 10},
+	86:  {minLength: 10, maxLength: 11},
+	90:  {minLength: 10, maxLength: 10},
+	91:  {minLength: 10, maxLength: 10},
+	92:  {minLength: 9, maxLength: 10},
+	94:  {minLength: 9, maxLength: 9},
+	98:  {minLength: 10, maxLength: 10},
+	225: {minLength: 10, maxLength: 10, keepLeadingZero: true},
+	234: {minLength: 8, maxLength: 10},
+	254: {minLength: 9, maxLength: 9},
+	351: {minLength: 9, maxLength: 9},
+	353: {minLength: 7, maxLength: 9},
+	358: {minLength: 5, maxLength: 12},
+	378: {minLength: 6, maxLength: 10, keepLeadingZero: true},
+	380: {minLength: 9, maxLength: 9},
+	420: {minLength: 9, maxLength: 9},
+	421: {minLength: 9, maxLength: 9},
+	852: {minLength: 8, maxLength: 8},
+	886: {minLength: 8, maxLength: 9},
+	966: {minLength: 8, maxLength: 9},
+	971: {minLength: 8, maxLength: 9},
+	972: {minLength: 8, maxLength: 9},
+}
+
+// callingCodes lists the assigned geographic country calling codes.
+var callingCodes = map[int]bool{}
+
+func init() {
+	for _, r := range [][2]int{
+		{1, 1}, {7, 7}, {20, 20}, {27, 27}, {30, 34}, {36, 36}, {39, 41}, {43, 49},
+		{51, 58}, {60, 66}, {81, 82}, {84, 84}, {86, 86}, {90, 95}, {98, 98},
+		{211, 212}, {213, 213}, {216, 216}, {218, 218}, {220, 258}, {260, 269},
+		{290, 291}, {297, 299}, {350, 359}, {370, 378}, {380, 383}, {385, 387}, {389, 389},
+		{420, 421}, {423, 423}, {500, 509}, {590, 599},
+		{670, 670}, {672, 683}, {685, 692}, {850, 850}, {852, 853}, {855, 856}, {880, 880}, {886, 886},
+		{960, 968}, {970, 977}, {992, 996}, {998, 998},
+	} {
+		for code := r[0]; code <= r[1]; code++ {
+			callingCodes[code] = true
+		}
+	}
+}
+
+// IsCallingCode reports whether a country calling code is assigned to a country.
+func IsCallingCode(code int) bool {
+	return callingCodes[code]
+}
+
+// Normalize returns the national significant number of a phone number of a
+// country. The number may be formatted with spaces, dashes, dots and
+// parentheses, and may be prefixed by the country calling code with a "+" or
+// "00", or by the trunk prefix of the country.
+func Normalize(countryCode int, number string) (string, error) {
+	if !IsCallingCode(countryCode) {
+		return "", fmt.Errorf("%d is not a country calling code", countryCode)
+	}
+
+	digits := strings.Map(func(r rune) rune {
+		switch r {
+		case ' ', '-', '.', '(', ')', '/':
+			return -1
+		}
+		return r
+	}, strings.TrimSpace(number))
+
+	code := strconv.Itoa(countryCode)
+
+	international := false
+	switch {
+	case strings.HasPrefix(digits, "+"):
+		digits, international = digits[1:], true
+	case strings.HasPrefix(digits, "00"+code):
+		digits, international = digits[2:], true
+	}
+
+	for _, r := range digits {
+		if r < '0' || r > '9' {
+			return "", fmt.Errorf("phone number %q can only contain digits, spaces, dashes, dots, parentheses and a leading +", number)
+		}
+	}
+
+	if international {
+		if !strings.HasPrefix(digits, code) {
+			return "", fmt.Errorf("phone number %q doesn't start with the country calling code +%d", number, countryCode)
+		}
+		digits = digits[len(code):]
+	}
+
+	c, known := countries[countryCode]
+	if !known {
+		c = country{minLength: 4, maxLength: maxDigits - len(code)}
+	}
+
+	// Trunk prefixes are dropped: 0 for most countries, 1 in the North American
+	// Numbering Plan.
+	if countryCode == 1 && len(digits) == 11 && digits[0] == '1' {
+		digits = digits[1:]
+	} else if !c.keepLeadingZero {
+		digits = strings.TrimLeft(digits, "0")
+	}
+
+	if len(digits) < c.minLength || len(digits) > c.maxLength {
+		if c.minLength == c.maxLength {
+			return "", fmt.Errorf("phone number %q must have %d digits after the country calling code +%d", number, c.minLength, countryCode)
+		}
+		return "", fmt.Errorf("phone number %q must have between %d and %d digits after the country calling code +%d", number, c.minLength, c.maxLength, countryCode)
+	}
+
+	// Area codes and exchanges of the North American Numbering Plan can't start with 0 or 1.
+	if countryCode == 1 && (digits[0] < '2' || digits[3] < '2') {
+		return "", fmt.Errorf("phone number %q is not a valid North American number", number)
+	}
+
+	return digits, nil
+}
+
+// Equal reports whether two phone numbers of a country are the same number
+// written differently.
+func Equal(countryCode int, a, b string) bool {
+	na, err := Normalize(countryCode, a)
+	if err != nil {
+		return false
+	}
+	nb, err := Normalize(countryCode, b)
+	if err != nil {
+		return false
+	}
+	return na == nb
+}
@@ -0,0 +1,71 @@
+package phonenumber
+
+import "testing"
+
+func TestNormalize(t *testing.T) {
+	cases := []struct {
+		countryCode int
+		number      string
+		want        string
+	}{
+		{1, "4153013250", "4153013250"},
+		{1, "(415) 301-3250", "4153013250"},
+		{1, "+1 415 301 3250", "4153013250"},
+		{1, "1-415-301-3250", "4153013250"},
+		{33, "06 12 34 56 78", "612345678"},
+		{33, "+33 6 12 34 56 78", "612345678"},
+		{33, "0033612345678", "612345678"},
+		{44, "07700 900123", "7700900123"},
+		{39, "06 1234 5678", "0612345678"},
+		{39, "+39 06 1234 5678", "0612345678"},
+		{49, "030/1234567", "301234567"},
+		{354, "555 1234", "5551234"},
+	}
+
+	for _, c := range cases {
+		got, err := Normalize(c.countryCode, c.number)
+		if err != nil {
+			t.Errorf("+%d %q: %v", c.countryCode, c.number, err)
+			continue
+		}
+		if got != c.want {
+			t.Errorf("+%d %q: got %q, want %q", c.countryCode, c.number, got, c.want)
+		}
+	}
+}
+
+func TestNormalizeErrors(t *testing.T) {
+	cases := []struct {
+		countryCode int
+		number      string
+	}{
+		{0, "4153013250"},
+		{999, "4153013250"},
+		{1, "415301325"},
+		{1, "0153013250"},
+		{1, "4151013250"},
+		{1, "+44 7700 900123"},
+		{1, "415-CALL-NOW"},
+		{33, "6123456789"},
+		{44, "+44"},
+		{354, "+354 1234567890123"},
+	}
+
+	for _, c := range cases {
+		if got, err := Normalize(c.countryCode, c.number); err == nil {
+			t.Errorf("expected +%d %q to be invalid, got %q", c.countryCode, c.number, got)
+		}
+	}
+}
+
+func TestEqual(t *testing.T) {
+	if !Equal(1, "4153013250", "+1 (415) 301-3250") {
+		t.Error("expected formats of the same number to be equal")
+	}
+	if Equal(1, "4153013250", "4153013251") {
+		t.Error("expected different numbers not to be equal")
+	}
+	if Equal(1, "invalid", "invalid") {
+		t.Error("expected invalid numbers not to be equal")
+	}
+}
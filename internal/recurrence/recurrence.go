@@ -0,0 +1,429 @@
+// Package recurrence computes the occurrences of the cron expressions and
+// iCalendar recurrence rules used to schedule recurring maintenance windows.
+package recurrence
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// searchLimit bounds the number of days searched for the next occurrence, so
+// that rules which never match (e.g. February 30th) terminate.
+const searchLimit = 366 * 10
+
+// Schedule is a parsed recurrence.
+type Schedule struct {
+	loc      *time.Location
+	matchDay func(day time.Time) bool
+	hours    []int
+	minutes  []int
+	start    time.Time
+}
+
+// Parse parses a recurrence in the given location. The recurrence is either
+// a standard five fields cron expression, e.g. "0 2 * * SUN", or an RRULE,
+// e.g. "FREQ=WEEKLY;BYDAY=SU;BYHOUR=2;BYMINUTE=0", with an optional "RRULE:"
+// prefix.
+//
+// start is optional. No occurrence is scheduled before it and it anchors the
+// INTERVAL of RRULEs as well as their day and time when none is given.
+func Parse(expr string, loc *time.Location, start time.Time) (*Schedule, error) {
+	expr = strings.TrimSpace(expr)
+	if loc == nil {
+		loc = time.UTC
+	}
+	if !start.IsZero() {
+		start = start.In(loc)
+	}
+
+	if IsRRule(expr) {
+		return parseRRule(strings.TrimPrefix(expr, "RRULE:"), loc, start)
+	}
+	return parseCron(expr, loc, start)
+}
+
+// IsRRule reports whether a recurrence is an RRULE rather than a cron expression.
+func IsRRule(expr string) bool {
+	expr = strings.TrimSpace(expr)
+	return strings.HasPrefix(expr, "RRULE:") || strings.HasPrefix(expr, "FREQ=")
+}
+
+// Next returns the first occurrence strictly after t.
+func (s *Schedule) Next(t time.Time) (time.Time, bool) {
+	t = t.In(s.loc)
+	if !s.start.IsZero() && t.Before(s.start) {
+		// Occurrences at the start itself are included.
+		t = s.start.Add(-time.Nanosecond)
+	}
+
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, s.loc)
+	for i := 0; i < searchLimit; i++ {
+		if s.matchDay(day) {
+			for _, h := range s.hours {
+				for _, m := range s.minutes {
+					o := time.Date(day.Year(), day.Month(), day.Day(), h, m, 0, 0, s.loc)
+					// Skip the times that don't exist on daylight saving time changes.
+					if o.Hour() != h || o.Minute() != m {
+						continue
+					}
+					if o.After(t) {
+						return o, true
+					}
+				}
+			}
+		}
+		day = day.AddDate(0, 0, 1)
+	}
+
+	return time.Time{}, false
+}
+
+// Occurrences returns up to n occurrences strictly after t.
+func (s *Schedule) Occurrences(t time.Time, n int) []time.Time {
+	var occurrences []time.Time
+	for len(occurrences) < n {
+		o, ok := s.Next(t)
+		if !ok {
+			break
+		}
+		occurrences = append(occurrences, o)
+		t = o
+	}
+	return occurrences
+}
+
+var weekdays = map[string]time.Weekday{
+	"SU": time.Sunday,
+	"MO": time.Monday,
+	"TU": time.Tuesday,
+	"WE": time.Wednesday,
+	"TH": time.Thursday,
+	"FR": time.Friday,
+	"SA": time.Saturday,
+}
+
+type byDay struct {
+	weekday time.Weekday
+	// ordinal selects the nth weekday of the month, counted from the end
+	// when negative. Zero selects every such weekday.
+	ordinal int
+}
+
+func parseRRule(expr string, loc *time.Location, start time.Time) (*Schedule, error) {
+	parts := make(map[string]string)
+	for _, p := range strings.Split(expr, ";") {
+		kv := strings.SplitN(p, "=", 2)
+		if len(kv) != 2 || kv[1] == "" {
+			return nil, fmt.Errorf("invalid RRULE part %q", p)
+		}
+		if _, ok := parts[kv[0]]; ok {
+			return nil, fmt.Errorf("RRULE part %s is given twice", kv[0])
+		}
+		parts[kv[0]] = kv[1]
+	}
+
+	freq := parts["FREQ"]
+	interval := 1
+	var days []byDay
+	var monthDays, hours, minutes []int
+	var err error
+
+	for k, v := range parts {
+		switch k {
+		case "FREQ":
+			if freq != "DAILY" && freq != "WEEKLY" && freq != "MONTHLY" {
+				return nil, fmt.Errorf("FREQ must be one of DAILY, WEEKLY or MONTHLY, got %q", freq)
+			}
+		case "INTERVAL":
+			if interval, err = strconv.Atoi(v); err != nil || interval < 1 {
+				return nil, fmt.Errorf("INTERVAL must be a positive number, got %q", v)
+			}
+		case "BYDAY":
+			if days, err = parseByDay(v); err != nil {
+				return nil, err
+			}
+		case "BYMONTHDAY":
+			if monthDays, err = parseRRuleList(k, v, -31, 31); err != nil {
+				return nil, err
+			}
+			for _, d := range monthDays {
+				if d == 0 {
+					return nil, fmt.Errorf("BYMONTHDAY cannot contain 0")
+				}
+			}
+		case "BYHOUR":
+			if hours, err = parseRRuleList(k, v, 0, 23); err != nil {
+				return nil, err
+			}
+		case "BYMINUTE":
+			if minutes, err = parseRRuleList(k, v, 0, 59); err != nil {
+				return nil, err
+			}
+		default:
+			return nil, fmt.Errorf("RRULE part %s is not supported", k)
+		}
+	}
+
+	if freq == "" {
+		return nil, fmt.Errorf("RRULE needs a FREQ")
+	}
+	if freq != "MONTHLY" {
+		if len(monthDays) > 0 {
+			return nil, fmt.Errorf("BYMONTHDAY is only supported with FREQ=MONTHLY")
+		}
+		for _, d := range days {
+			if d.ordinal != 0 {
+				return nil, fmt.Errorf("BYDAY ordinals are only supported with FREQ=MONTHLY")
+			}
+		}
+	}
+	if start.IsZero() && interval > 1 {
+		return nil, fmt.Errorf("INTERVAL needs a start to count the intervals from")
+	}
+
+	// The day and time of the start apply when the rule doesn't give them.
+	if start.IsZero() && freq == "WEEKLY" && len(days) == 0 {
+		return nil, fmt.Errorf("FREQ=WEEKLY needs a BYDAY or a start to take the day from")
+	}
+	if start.IsZero() && freq == "MONTHLY" && len(days) == 0 && len(monthDays) == 0 {
+		return nil, fmt.Errorf("FREQ=MONTHLY needs a BYDAY, a BYMONTHDAY or a start to take the day from")
+	}
+	if len(hours) == 0 {
+		hours = []int{start.Hour()}
+	}
+	if len(minutes) == 0 {
+		minutes = []int{start.Minute()}
+	}
+	if freq == "WEEKLY" && len(days) == 0 {
+		days = []byDay{{weekday: start.Weekday()}}
+	}
+	if freq == "MONTHLY" && len(days) == 0 && len(monthDays) == 0 {
+		monthDays = []int{start.Day()}
+	}
+
+	matchDay := func(day time.Time) bool {
+		if interval > 1 && periodsSince(freq, start, day)%interval != 0 {
+			return false
+		}
+		if len(monthDays) > 0 && !matchMonthDay(monthDays, day) {
+			return false
+		}
+		if len(days) > 0 && !matchByDay(days, day) {
+			return false
+		}
+		return true
+	}
+
+	return &Schedule{loc: loc, matchDay: matchDay, hours: hours, minutes: minutes, start: start}, nil
+}
+
+func parseByDay(v string) ([]byDay, error) {
+	var days []byDay
+	for _, d := range strings.Split(v, ",") {
+		if len(d) < 2 {
+			return nil, fmt.Errorf("invalid BYDAY value %q", d)
+		}
+		weekday, ok := weekdays[d[len(d)-2:]]
+		if !ok {
+			return nil, fmt.Errorf("invalid BYDAY value %q", d)
+		}
+		day := byDay{weekday: weekday}
+		if n := d[:len(d)-2]; n != "" {
+			ordinal, err := strconv.Atoi(n)
+			if err != nil || ordinal == 0 || ordinal < -5 || ordinal > 5 {
+				return nil, fmt.Errorf("invalid BYDAY value %q", d)
+			}
+			day.ordinal = ordinal
+		}
+		days = append(days, day)
+	}
+	return days, nil
+}
+
+func parseRRuleList(k, v string, min, max int) ([]int, error) {
+	var values []int
+	for _, s := range strings.Split(v, ",") {
+		n, err := strconv.Atoi(s)
+		if err != nil || n < min || n > max {
+			return nil, fmt.Errorf("%s values must be between %d and %d, got %q", k, min, max, s)
+		}
+		values = append(values, n)
+	}
+	sort.Ints(values)
+	return values, nil
+}
+
+// periodsSince returns the number of days, weeks (starting on Monday) or
+// months between the start and a day.
+func periodsSince(freq string, start, day time.Time) int {
+	startDay := time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, time.UTC)
+	d := time.Date(day.Year(), day.Month(), day.Day(), 0, 0, 0, 0, time.UTC)
+
+	switch freq {
+	case "WEEKLY":
+		startDay = startDay.AddDate(0, 0, -((int(startDay.Weekday()) + 6) % 7))
+		d = d.AddDate(0, 0, -((int(d.Weekday()) + 6) % 7))
+		return int(d.Sub(startDay).Hours()) / (24 * 7)
+	case "MONTHLY":
+		return (d.Year()-startDay.Year())*12 + int(d.Month()) - int(startDay.Month())
+	default:
+		return int(d.Sub(startDay).Hours()) / 24
+	}
+}
+
+func daysInMonth(day time.Time) int {
+	return time.Date(day.Year(), day.Month()+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
+
+func matchMonthDay(monthDays []int, day time.Time) bool {
+	last := daysInMonth(day)
+	for _, d := range monthDays {
+		if d == day.Day() || (d < 0 && last+d+1 == day.Day()) {
+			return true
+		}
+	}
+	return false
+}
+
+func matchByDay(days []byDay, day time.Time) bool {
+	for _, d := range days {
+		if d.weekday != day.Weekday() {
+			continue
+		}
+		switch {
+		case d.ordinal == 0:
+			return true
+		case d.ordinal > 0 && (day.Day()-1)/7+1 == d.ordinal:
+			return true
+		case d.ordinal < 0 && (daysInMonth(day)-day.Day())/7+1 == -d.ordinal:
+			return true
+		}
+	}
+	return false
+}
+
+var cronNames = map[string]string{
+	"SUN": "0", "MON": "1", "TUE": "2", "WED": "3", "THU": "4", "FRI": "5", "SAT": "6",
+	"JAN": "1", "FEB": "2", "MAR": "3", "APR": "4", "MAY": "5", "JUN": "6",
+	"JUL": "7", "AUG": "8", "SEP": "9", "OCT": "10", "NOV": "11", "DEC": "12",
+}
+
+func parseCron(expr string, loc *time.Location, start time.Time) (*Schedule, error) {
+	fields := strings.Fields(expr)
+	if len(fields) != 5 {
+		return nil, fmt.Errorf("cron expression %q must have 5 fields: minute, hour, day of month, month and day of week", expr)
+	}
+
+	minutes, _, err := parseCronField("minute", fields[0], 0, 59)
+	if err != nil {
+		return nil, err
+	}
+	hours, _, err := parseCronField("hour", fields[1], 0, 23)
+	if err != nil {
+		return nil, err
+	}
+	monthDays, anyMonthDay, err := parseCronField("day of month", fields[2], 1, 31)
+	if err != nil {
+		return nil, err
+	}
+	months, _, err := parseCronField("month", fields[3], 1, 12)
+	if err != nil {
+		return nil, err
+	}
+	weekDays, anyWeekDay, err := parseCronField("day of week", fields[4], 0, 7)
+	if err != nil {
+		return nil, err
+	}
+
+	monthDaySet := toSet(monthDays)
+	monthSet := toSet(months)
+	weekDaySet := toSet(weekDays)
+	if weekDaySet[7] {
+		weekDaySet[0] = true
+	}
+
+	matchDay := func(day time.Time) bool {
+		if !monthSet[int(day.Month())] {
+			return false
+		}
+		// Like cron, a day matches either restricted day field when both are.
+		if !anyMonthDay && !anyWeekDay {
+			return monthDaySet[day.Day()] || weekDaySet[int(day.Weekday())]
+		}
+		return monthDaySet[day.Day()] && weekDaySet[int(day.Weekday())]
+	}
+
+	return &Schedule{loc: loc, matchDay: matchDay, hours: hours, minutes: minutes, start: start}, nil
+}
+
+// parseCronField parses a cron field made of comma separated values, ranges
+// and steps, e.g. "1-5", "*/15" or "MON,WED". It also reports whether the
+// field is a wildcard.
+func parseCronField(name, field string, min, max int) ([]int, bool, error) {
+	set := make(map[int]bool)
+
+	for _, part := range strings.Split(field, ",") {
+		rangePart, step := part, 1
+		if i := strings.Index(part, "/"); i >= 0 {
+			n, err := strconv.Atoi(part[i+1:])
+			if err != nil || n < 1 {
+				return nil, false, fmt.Errorf("invalid %s step in %q", name, part)
+			}
+			rangePart, step = part[:i], n
+		}
+
+		lo, hi := min, max
+		if rangePart != "*" {
+			bounds := strings.SplitN(rangePart, "-", 2)
+			var err error
+			if lo, err = parseCronValue(bounds[0], min, max); err != nil {
+				return nil, false, fmt.Errorf("invalid %s %q: %v", name, part, err)
+			}
+			hi = lo
+			if len(bounds) == 2 {
+				if hi, err = parseCronValue(bounds[1], min, max); err != nil {
+					return nil, false, fmt.Errorf("invalid %s %q: %v", name, part, err)
+				}
+			} else if step > 1 {
+				hi = max
+			}
+			if hi < lo {
+				return nil, false, fmt.Errorf("invalid %s range %q", name, part)
+			}
+		}
+
+		for v := lo; v <= hi; v += step {
+			set[v] = true
+		}
+	}
+
+	values := make([]int, 0, len(set))
+	for v := range set {
+		values = append(values, v)
+	}
+	sort.Ints(values)
+
+	return values, field == "*", nil
+}
+
+func parseCronValue(v string, min, max int) (int, error) {
+	if name, ok := cronNames[strings.ToUpper(v)]; ok {
+		v = name
+	}
+	n, err := strconv.Atoi(v)
+	if err != nil || n < min || n > max {
+		return 0, fmt.Errorf("must be between %d and %d", min, max)
+	}
+	return n, nil
+}
+
+func toSet(values []int) map[int]bool {
+	set := make(map[int]bool, len(values))
+	for _, v := range values {
+		set[v] = true
+	}
+	return set
+}
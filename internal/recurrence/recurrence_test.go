@@ -0,0 +1,139 @@
+package recurrence
+
+import (
+	"testing"
+	"time"
+)
+
+func mustLoadLocation(t *testing.T, name string) *time.Location {
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return loc
+}
+
+func testOccurrences(t *testing.T, expr string, loc *time.Location, start, after time.Time, want ...string) {
+	t.Helper()
+
+	s, err := Parse(expr, loc, start)
+	if err != nil {
+		t.Fatalf("%s: %v", expr, err)
+	}
+
+	got := s.Occurrences(after, len(want))
+	if len(got) != len(want) {
+		t.Fatalf("%s: got %d occurrences %v, want %d", expr, len(got), got, len(want))
+	}
+	for i := range want {
+		if f := got[i].Format(time.RFC3339); f != want[i] {
+			t.Errorf("%s: occurrence %d is %s, want %s", expr, i, f, want[i])
+		}
+	}
+}
+
+func TestCron(t *testing.T) {
+	paris := mustLoadLocation(t, "Europe/Paris")
+	// Wednesday March 20th, 2024.
+	after := time.Date(2024, 3, 20, 12, 0, 0, 0, time.UTC)
+
+	testOccurrences(t, "0 2 * * SUN", paris, time.Time{}, after,
+		"2024-03-24T02:00:00+01:00",
+		// 2am doesn't exist when daylight saving time starts on March 31st.
+		"2024-04-07T02:00:00+02:00",
+		"2024-04-14T02:00:00+02:00",
+	)
+	testOccurrences(t, "30 22 * * 1-5", time.UTC, time.Time{}, after,
+		"2024-03-20T22:30:00Z",
+		"2024-03-21T22:30:00Z",
+		"2024-03-22T22:30:00Z",
+		"2024-03-25T22:30:00Z",
+	)
+	testOccurrences(t, "0 */12 1,15 * *", time.UTC, time.Time{}, after,
+		"2024-04-01T00:00:00Z",
+		"2024-04-01T12:00:00Z",
+		"2024-04-15T00:00:00Z",
+	)
+	// Both day fields are restricted, so either matches.
+	testOccurrences(t, "0 0 1 * 7", time.UTC, time.Time{}, after,
+		"2024-03-24T00:00:00Z",
+		"2024-03-31T00:00:00Z",
+		"2024-04-01T00:00:00Z",
+	)
+	testOccurrences(t, "0 3 * * *", time.UTC, time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC), after,
+		"2024-04-01T03:00:00Z",
+		"2024-04-02T03:00:00Z",
+	)
+}
+
+func TestRRule(t *testing.T) {
+	newYork := mustLoadLocation(t, "America/New_York")
+	after := time.Date(2024, 3, 20, 12, 0, 0, 0, time.UTC)
+
+	testOccurrences(t, "FREQ=WEEKLY;BYDAY=SU;BYHOUR=2;BYMINUTE=0", time.UTC, time.Time{}, after,
+		"2024-03-24T02:00:00Z",
+		"2024-03-31T02:00:00Z",
+	)
+	testOccurrences(t, "RRULE:FREQ=WEEKLY;BYDAY=TU,TH;BYHOUR=23;BYMINUTE=30", newYork, time.Time{}, after,
+		"2024-03-21T23:30:00-04:00",
+		"2024-03-26T23:30:00-04:00",
+		"2024-03-28T23:30:00-04:00",
+	)
+	// Every other week on the day and time of the start.
+	testOccurrences(t, "FREQ=WEEKLY;INTERVAL=2", time.UTC, time.Date(2024, 3, 2, 6, 15, 0, 0, time.UTC), after,
+		"2024-03-30T06:15:00Z",
+		"2024-04-13T06:15:00Z",
+	)
+	testOccurrences(t, "FREQ=MONTHLY;BYDAY=-1FR;BYHOUR=18", time.UTC, time.Time{}, after,
+		"2024-03-29T18:00:00Z",
+		"2024-04-26T18:00:00Z",
+		"2024-05-31T18:00:00Z",
+	)
+	testOccurrences(t, "FREQ=MONTHLY;BYDAY=2MO;BYHOUR=1", time.UTC, time.Time{}, after,
+		"2024-04-08T01:00:00Z",
+		"2024-05-13T01:00:00Z",
+	)
+	testOccurrences(t, "FREQ=MONTHLY;BYMONTHDAY=31", time.UTC, time.Time{}, after,
+		"2024-03-31T00:00:00Z",
+		"2024-05-31T00:00:00Z",
+	)
+	testOccurrences(t, "FREQ=DAILY;INTERVAL=3;BYHOUR=4", time.UTC, time.Date(2024, 3, 19, 0, 0, 0, 0, time.UTC), after,
+		"2024-03-22T04:00:00Z",
+		"2024-03-25T04:00:00Z",
+	)
+}
+
+func TestParseErrors(t *testing.T) {
+	for _, expr := range []string{
+		"",
+		"0 2 * *",
+		"60 2 * * *",
+		"0 2 * * FUNDAY",
+		"0 5-2 * * *",
+		"0 2 */0 * *",
+		"FREQ=YEARLY",
+		"FREQ=WEEKLY",
+		"FREQ=WEEKLY;BYDAY=SU;INTERVAL=2",
+		"FREQ=WEEKLY;BYDAY=1SU",
+		"FREQ=DAILY;BYMONTHDAY=1",
+		"FREQ=MONTHLY;BYMONTHDAY=0",
+		"FREQ=DAILY;BYHOUR=24",
+		"FREQ=DAILY;COUNT=3",
+		"FREQ=DAILY;FREQ=WEEKLY",
+		"BYHOUR=2",
+	} {
+		if _, err := Parse(expr, time.UTC, time.Time{}); err == nil {
+			t.Errorf("expected %q to be invalid", expr)
+		}
+	}
+}
+
+func TestNextNeverMatches(t *testing.T) {
+	s, err := Parse("0 0 30 2 *", time.UTC, time.Time{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if o, ok := s.Next(time.Now()); ok {
+		t.Errorf("expected February 30th never to occur, got %s", o)
+	}
+}
@@ -20,17 +20,42 @@ type Config struct {
 	// The PagerDuty APP URL
 	AppUrl string
 
+	// Override default PagerDuty APP URL
+	AppUrlOverride string
+
+	// The PagerDuty Events API URL
+	EventsUrl string
+
+	// Override default PagerDuty Events API URL
+	EventsUrlOverride string
+
 	// The PagerDuty API V2 token
 	Token string
 
 	// The PagerDuty User level token for Slack
 	UserToken string
 
+	// The Slack workspace of the Slack connections that don't set one
+	SlackWorkspaceID string
+
+	// The email of the user performing the requests which require a From
+	// header, used when resources don't set one
+	DefaultFromEmail string
+
 	// Skip validation of the token against the PagerDuty API
 	SkipCredsValidation bool
 
 	// UserAgent for API Client
 	UserAgent string
+
+	// Supporting services planned by pagerduty_service_dependencies resources
+	serviceDependencyGraph *serviceDependencyGraph
+
+	// Notification rules planned by pagerduty_user_notification_rule resources
+	userNotificationRulePlan *userNotificationRulePlan
+
+	// Names of the references of pagerduty_response_play resources
+	responsePlayReferenceNames *responsePlayReferenceNames
 }
 
 const invalidCreds = `
@@ -57,11 +82,12 @@ func (c *Config) Client() (*pagerduty.Client, error) {
 	}
 
 	config := &pagerduty.Config{
-		BaseURL:    apiUrl,
-		Debug:      logging.IsDebugOrHigher(),
-		HTTPClient: httpClient,
-		Token:      c.Token,
-		UserAgent:  c.UserAgent,
+		BaseURL:          apiUrl,
+		Debug:            logging.IsDebugOrHigher(),
+		HTTPClient:       httpClient,
+		Token:            c.Token,
+		UserAgent:        c.UserAgent,
+		DefaultFromEmail: c.DefaultFromEmail,
 	}
 
 	client, err := pagerduty.NewClient(config)
@@ -92,8 +118,13 @@ func (c *Config) SlackClient() (*pagerduty.Client, error) {
 	httpClient = http.DefaultClient
 	httpClient.Transport = logging.NewTransport("PagerDuty", http.DefaultTransport)
 
+	var appUrl = c.AppUrl
+	if c.AppUrlOverride != "" {
+		appUrl = c.AppUrlOverride
+	}
+
 	config := &pagerduty.Config{
-		BaseURL:    c.AppUrl,
+		BaseURL:    appUrl,
 		Debug:      logging.IsDebugOrHigher(),
 		HTTPClient: httpClient,
 		Token:      c.UserToken,
@@ -109,3 +140,31 @@ func (c *Config) SlackClient() (*pagerduty.Client, error) {
 
 	return client, nil
 }
+
+// EventsClient returns a new PagerDuty Events API v2 client
+func (c *Config) EventsClient() (*pagerduty.EventsV2Client, error) {
+	var httpClient *http.Client
+	httpClient = http.DefaultClient
+	httpClient.Transport = logging.NewTransport("PagerDuty", http.DefaultTransport)
+
+	var eventsUrl = c.EventsUrl
+	if c.EventsUrlOverride != "" {
+		eventsUrl = c.EventsUrlOverride
+	}
+
+	config := &pagerduty.EventsV2Config{
+		BaseURL:    eventsUrl,
+		Debug:      logging.IsDebugOrHigher(),
+		HTTPClient: httpClient,
+		UserAgent:  c.UserAgent,
+	}
+
+	client, err := pagerduty.NewEventsV2Client(config)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Printf("[INFO] PagerDuty client configured for events")
+
+	return client, nil
+}
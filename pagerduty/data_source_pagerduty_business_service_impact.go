@@ -0,0 +1,144 @@
+package pagerduty
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/heimweh/go-pagerduty/pagerduty"
+)
+
+func dataSourcePagerDutyBusinessServiceImpact() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourcePagerDutyBusinessServiceImpactRead,
+
+		Schema: map[string]*schema.Schema{
+			"business_service_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The ID of the business service whose impact to report",
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"highest_impacting_priority": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"impacting_services": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"highest_impacting_priority": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+var businessServiceImpactOptions = pagerduty.ListBusinessServiceImpactsOptions{
+	AdditionalFields: []string{"services.highest_impacting_priority"},
+}
+
+func dataSourcePagerDutyBusinessServiceImpactRead(d *schema.ResourceData, meta interface{}) error {
+	client, _ := meta.(*Config).Client()
+
+	id := d.Get("business_service_id").(string)
+
+	log.Printf("[INFO] Reading PagerDuty business service impact %s", id)
+
+	return resource.Retry(2*time.Minute, func() *resource.RetryError {
+		o := businessServiceImpactOptions
+		o.IDs = []string{id}
+
+		impacts, err := client.BusinessServices.ListAllImpacts(&o)
+		if err == nil {
+			var supporting []*pagerduty.BusinessServiceImpact
+			so := businessServiceImpactOptions
+			if supporting, err = client.BusinessServices.ListAllSupportingServiceImpacts(id, &so); err == nil {
+				impacts = append(impacts, supporting...)
+			}
+		}
+		if err != nil {
+			if isErrCode(err, 429) {
+				// Delaying retry by 30s as recommended by PagerDuty
+				// https://developer.pagerduty.com/docs/rest-api-v2/rate-limiting/#what-are-possible-workarounds-to-the-events-api-rate-limit
+				time.Sleep(30 * time.Second)
+				return resource.RetryableError(err)
+			}
+
+			return resource.NonRetryableError(err)
+		}
+
+		var found *pagerduty.BusinessServiceImpact
+		var impacting []interface{}
+
+		for _, impact := range impacts {
+			if impact.ID == id && found == nil {
+				found = impact
+				continue
+			}
+			if impact.Status != "impacted" {
+				continue
+			}
+			impacting = append(impacting, map[string]interface{}{
+				"id":                         impact.ID,
+				"name":                       impact.Name,
+				"type":                       impact.Type,
+				"status":                     impact.Status,
+				"highest_impacting_priority": highestImpactingPriority(impact),
+			})
+		}
+
+		if found == nil {
+			return resource.NonRetryableError(
+				fmt.Errorf("Unable to locate any business service with ID: %s", id),
+			)
+		}
+
+		d.SetId(found.ID)
+		d.Set("name", found.Name)
+		d.Set("status", found.Status)
+		d.Set("highest_impacting_priority", highestImpactingPriority(found))
+		d.Set("impacting_services", impacting)
+
+		return nil
+	})
+}
+
+// highestImpactingPriority returns the ID of the highest priority of the
+// incidents impacting a service, if any.
+func highestImpactingPriority(impact *pagerduty.BusinessServiceImpact) string {
+	if impact.AdditionalFields == nil || impact.AdditionalFields.HighestImpactingPriority == nil {
+		return ""
+	}
+	return impact.AdditionalFields.HighestImpactingPriority.ID
+}
@@ -0,0 +1,100 @@
+package pagerduty
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestDataSourcePagerDutyBusinessServiceImpactRead(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Early-Access") != "business-impact-early-access" {
+			t.Errorf("expected the early access header, got %q", r.Header.Get("X-Early-Access"))
+		}
+		if r.URL.Query().Get("additional_fields[]") != "services.highest_impacting_priority" {
+			t.Errorf("expected the highest impacting priority to be requested, got %q", r.URL.RawQuery)
+		}
+
+		switch r.URL.Path {
+		case "/business_services/impacts":
+			if r.URL.Query().Get("ids[]") != "PBIZ123" {
+				t.Errorf("unexpected IDs %q", r.URL.RawQuery)
+			}
+			w.Write([]byte(`{"services":[{"id":"PBIZ123","name":"Checkout","type":"business_service","status":"impacted","additional_fields":{"highest_impacting_priority":{"id":"PP1ABCD","order":256}}}]}`))
+		case "/business_services/PBIZ123/supporting_services/impacts":
+			switch r.URL.Query().Get("offset") {
+			case "":
+				w.Write([]byte(`{"services":[{"id":"PSVC123","name":"Payments","type":"service","status":"impacted","additional_fields":{"highest_impacting_priority":{"id":"PP1ABCD","order":256}}},{"id":"PSVC456","name":"Cart","type":"service","status":"not_impacted"}],"limit":2,"more":true}`))
+			case "2":
+				w.Write([]byte(`{"services":[{"id":"PSVC789","name":"Search","type":"service","status":"impacted"}],"limit":2,"more":false}`))
+			default:
+				t.Errorf("unexpected offset %q", r.URL.RawQuery)
+			}
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	}))
+	defer srv.Close()
+
+	d := schema.TestResourceDataRaw(t, dataSourcePagerDutyBusinessServiceImpact().Schema, map[string]interface{}{
+		"business_service_id": "PBIZ123",
+	})
+
+	config := &Config{Token: "foo", ApiUrlOverride: srv.URL, SkipCredsValidation: true}
+	if err := dataSourcePagerDutyBusinessServiceImpactRead(d, config); err != nil {
+		t.Fatal(err)
+	}
+
+	if d.Id() != "PBIZ123" || d.Get("name").(string) != "Checkout" || d.Get("status").(string) != "impacted" {
+		t.Errorf("unexpected business service %q, %q, %q", d.Id(), d.Get("name"), d.Get("status"))
+	}
+	if p := d.Get("highest_impacting_priority").(string); p != "PP1ABCD" {
+		t.Errorf("unexpected highest impacting priority %q", p)
+	}
+	if n := d.Get("impacting_services.#").(int); n != 2 {
+		t.Fatalf("expected 2 impacting services, got %d", n)
+	}
+	if id, p := d.Get("impacting_services.0.id").(string), d.Get("impacting_services.0.highest_impacting_priority").(string); id != "PSVC123" || p != "PP1ABCD" {
+		t.Errorf("unexpected impacting service %q with priority %q", id, p)
+	}
+	if id := d.Get("impacting_services.1.id").(string); id != "PSVC789" {
+		t.Errorf("expected the impacting service of the second page, got %q", id)
+	}
+}
+
+func TestAccDataSourcePagerDutyBusinessServiceImpact_Basic(t *testing.T) {
+	name := fmt.Sprintf("tf-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourcePagerDutyBusinessServiceImpactConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.pagerduty_business_service_impact.foo", "name", name),
+					resource.TestCheckResourceAttr("data.pagerduty_business_service_impact.foo", "status", "not_impacted"),
+					resource.TestCheckResourceAttr("data.pagerduty_business_service_impact.foo", "impacting_services.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourcePagerDutyBusinessServiceImpactConfig(name string) string {
+	return fmt.Sprintf(`
+resource "pagerduty_business_service" "foo" {
+  name = "%s"
+}
+
+data "pagerduty_business_service_impact" "foo" {
+  business_service_id = pagerduty_business_service.foo.id
+}
+`, name)
+}
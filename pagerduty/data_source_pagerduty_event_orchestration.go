@@ -0,0 +1,103 @@
+package pagerduty
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/heimweh/go-pagerduty/pagerduty"
+)
+
+func dataSourcePagerDutyEventOrchestration() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourcePagerDutyEventOrchestrationRead,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"integration": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"parameters": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"routing_key": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"type": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourcePagerDutyEventOrchestrationRead(d *schema.ResourceData, meta interface{}) error {
+	client, _ := meta.(*Config).Client()
+
+	log.Printf("[INFO] Reading PagerDuty event orchestration")
+
+	searchName := d.Get("name").(string)
+
+	return resource.Retry(2*time.Minute, func() *resource.RetryError {
+		resp, _, err := client.EventOrchestrations.List()
+		if err != nil {
+			if isErrCode(err, 429) {
+				// Delaying retry by 30s as recommended by PagerDuty
+				// https://developer.pagerduty.com/docs/rest-api-v2/rate-limiting/#what-are-possible-workarounds-to-the-events-api-rate-limit
+				time.Sleep(30 * time.Second)
+				return resource.RetryableError(err)
+			}
+
+			return resource.NonRetryableError(err)
+		}
+
+		var found *pagerduty.EventOrchestration
+
+		for _, orchestration := range resp.Orchestrations {
+			if orchestration.Name == searchName {
+				found = orchestration
+				break
+			}
+		}
+
+		if found == nil {
+			return resource.NonRetryableError(
+				fmt.Errorf("Unable to locate any event orchestration with the name: %s", searchName),
+			)
+		}
+
+		// The list endpoint doesn't return integrations.
+		orchestration, _, err := client.EventOrchestrations.Get(found.ID)
+		if err != nil {
+			return resource.RetryableError(err)
+		}
+
+		d.SetId(orchestration.ID)
+		d.Set("name", orchestration.Name)
+		if err := d.Set("integration", flattenEventOrchestrationIntegrations(orchestration.Integrations)); err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+}
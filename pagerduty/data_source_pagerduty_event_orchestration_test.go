@@ -0,0 +1,64 @@
+package pagerduty
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccDataSourcePagerDutyEventOrchestration_Basic(t *testing.T) {
+	name := fmt.Sprintf("tf-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourcePagerDutyEventOrchestrationConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccDataSourcePagerDutyEventOrchestration("pagerduty_event_orchestration.test", "data.pagerduty_event_orchestration.by_name"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourcePagerDutyEventOrchestration(src, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+
+		srcR := s.RootModule().Resources[src]
+		srcA := srcR.Primary.Attributes
+
+		r := s.RootModule().Resources[n]
+		a := r.Primary.Attributes
+
+		if a["id"] == "" {
+			return fmt.Errorf("Expected to get an event orchestration ID from PagerDuty")
+		}
+
+		testAtts := []string{"id", "name", "integration.#", "integration.0.parameters.0.routing_key"}
+
+		for _, att := range testAtts {
+			if a[att] != srcA[att] {
+				return fmt.Errorf("Expected the event orchestration %s to be: %s, but got: %s", att, srcA[att], a[att])
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccDataSourcePagerDutyEventOrchestrationConfig(name string) string {
+	return fmt.Sprintf(`
+resource "pagerduty_event_orchestration" "test" {
+  name = "%s"
+}
+
+data "pagerduty_event_orchestration" "by_name" {
+  name = pagerduty_event_orchestration.test.name
+}
+`, name)
+}
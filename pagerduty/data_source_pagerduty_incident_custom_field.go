@@ -0,0 +1,89 @@
+package pagerduty
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/heimweh/go-pagerduty/pagerduty"
+)
+
+func dataSourcePagerDutyIncidentCustomField() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourcePagerDutyIncidentCustomFieldRead,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"display_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"data_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"field_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourcePagerDutyIncidentCustomFieldRead(d *schema.ResourceData, meta interface{}) error {
+	client, _ := meta.(*Config).Client()
+
+	log.Printf("[INFO] Reading PagerDuty incident custom field")
+
+	searchName := d.Get("name").(string)
+
+	return resource.Retry(2*time.Minute, func() *resource.RetryError {
+		resp, _, err := client.IncidentCustomFields.List()
+		if err != nil {
+			if isErrCode(err, 429) {
+				// Delaying retry by 30s as recommended by PagerDuty
+				// https://developer.pagerduty.com/docs/rest-api-v2/rate-limiting/#what-are-possible-workarounds-to-the-events-api-rate-limit
+				time.Sleep(30 * time.Second)
+				return resource.RetryableError(err)
+			}
+
+			return resource.NonRetryableError(err)
+		}
+
+		var found *pagerduty.IncidentCustomField
+
+		for _, field := range resp.Fields {
+			if field.Name == searchName {
+				found = field
+				break
+			}
+		}
+
+		if found == nil {
+			return resource.NonRetryableError(
+				fmt.Errorf("Unable to locate any incident custom field with the name: %s", searchName),
+			)
+		}
+
+		d.SetId(found.ID)
+		d.Set("name", found.Name)
+		d.Set("display_name", found.DisplayName)
+		d.Set("data_type", found.DataType)
+		d.Set("field_type", found.FieldType)
+
+		if found.Description != nil {
+			d.Set("description", *found.Description)
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,68 @@
+package pagerduty
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccDataSourcePagerDutyIncidentCustomField_Basic(t *testing.T) {
+	name := fmt.Sprintf("tf_%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourcePagerDutyIncidentCustomFieldConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccDataSourcePagerDutyIncidentCustomField("pagerduty_incident_custom_field.test", "data.pagerduty_incident_custom_field.by_name"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourcePagerDutyIncidentCustomField(src, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+
+		srcR := s.RootModule().Resources[src]
+		srcA := srcR.Primary.Attributes
+
+		r := s.RootModule().Resources[n]
+		a := r.Primary.Attributes
+
+		if a["id"] == "" {
+			return fmt.Errorf("Expected to get an incident custom field ID from PagerDuty")
+		}
+
+		testAtts := []string{"id", "name", "display_name", "description", "data_type", "field_type"}
+
+		for _, att := range testAtts {
+			if a[att] != srcA[att] {
+				return fmt.Errorf("Expected the incident custom field %s to be: %s, but got: %s", att, srcA[att], a[att])
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccDataSourcePagerDutyIncidentCustomFieldConfig(name string) string {
+	return fmt.Sprintf(`
+resource "pagerduty_incident_custom_field" "test" {
+  name         = "%s"
+  display_name = "Customer impact"
+  description  = "foo"
+  data_type    = "integer"
+  field_type   = "single_value"
+}
+
+data "pagerduty_incident_custom_field" "by_name" {
+  name = pagerduty_incident_custom_field.test.name
+}
+`, name)
+}
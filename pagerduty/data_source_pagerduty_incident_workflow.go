@@ -0,0 +1,82 @@
+package pagerduty
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/heimweh/go-pagerduty/pagerduty"
+)
+
+func dataSourcePagerDutyIncidentWorkflow() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourcePagerDutyIncidentWorkflowRead,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"team": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourcePagerDutyIncidentWorkflowRead(d *schema.ResourceData, meta interface{}) error {
+	client, _ := meta.(*Config).Client()
+
+	log.Printf("[INFO] Reading PagerDuty incident workflow")
+
+	searchName := d.Get("name").(string)
+
+	return resource.Retry(2*time.Minute, func() *resource.RetryError {
+		resp, _, err := client.IncidentWorkflows.List()
+		if err != nil {
+			if isErrCode(err, 429) {
+				// Delaying retry by 30s as recommended by PagerDuty
+				// https://developer.pagerduty.com/docs/rest-api-v2/rate-limiting/#what-are-possible-workarounds-to-the-events-api-rate-limit
+				time.Sleep(30 * time.Second)
+				return resource.RetryableError(err)
+			}
+
+			return resource.NonRetryableError(err)
+		}
+
+		var found *pagerduty.IncidentWorkflow
+
+		for _, workflow := range resp.IncidentWorkflows {
+			if workflow.Name == searchName {
+				found = workflow
+				break
+			}
+		}
+
+		if found == nil {
+			return resource.NonRetryableError(
+				fmt.Errorf("Unable to locate any incident workflow with the name: %s", searchName),
+			)
+		}
+
+		d.SetId(found.ID)
+		d.Set("name", found.Name)
+
+		if found.Description != nil {
+			d.Set("description", *found.Description)
+		}
+
+		if found.Team != nil {
+			d.Set("team", found.Team.ID)
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,65 @@
+package pagerduty
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccDataSourcePagerDutyIncidentWorkflow_Basic(t *testing.T) {
+	name := fmt.Sprintf("tf-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourcePagerDutyIncidentWorkflowConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccDataSourcePagerDutyIncidentWorkflow("pagerduty_incident_workflow.test", "data.pagerduty_incident_workflow.by_name"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourcePagerDutyIncidentWorkflow(src, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+
+		srcR := s.RootModule().Resources[src]
+		srcA := srcR.Primary.Attributes
+
+		r := s.RootModule().Resources[n]
+		a := r.Primary.Attributes
+
+		if a["id"] == "" {
+			return fmt.Errorf("Expected to get an incident workflow ID from PagerDuty")
+		}
+
+		testAtts := []string{"id", "name", "description"}
+
+		for _, att := range testAtts {
+			if a[att] != srcA[att] {
+				return fmt.Errorf("Expected the incident workflow %s to be: %s, but got: %s", att, srcA[att], a[att])
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccDataSourcePagerDutyIncidentWorkflowConfig(name string) string {
+	return fmt.Sprintf(`
+resource "pagerduty_incident_workflow" "test" {
+  name        = "%s"
+  description = "foo"
+}
+
+data "pagerduty_incident_workflow" "by_name" {
+  name = pagerduty_incident_workflow.test.name
+}
+`, name)
+}
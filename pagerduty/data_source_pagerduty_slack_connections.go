@@ -0,0 +1,118 @@
+package pagerduty
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourcePagerDutySlackConnections() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourcePagerDutySlackConnectionsRead,
+
+		Schema: map[string]*schema.Schema{
+			"source_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The ID of the service or team whose Slack connections to list",
+			},
+			"workspace_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"connections": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"source_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"source_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"channel_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"channel_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"notification_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"config": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"events": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"priorities": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"urgency": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourcePagerDutySlackConnectionsRead(d *schema.ResourceData, meta interface{}) error {
+	client, err := meta.(*Config).SlackClient()
+	if err != nil {
+		return err
+	}
+
+	workspaceID, err := chatConnectionContainerID(slackConnectionPlatform, d, meta)
+	if err != nil {
+		return err
+	}
+	sourceID := d.Get("source_id").(string)
+
+	log.Printf("[INFO] Reading PagerDuty slack connections of %s in workspace %s", sourceID, workspaceID)
+
+	return resource.Retry(2*time.Minute, func() *resource.RetryError {
+		connections, err := slackConnectionPlatform.list(client, workspaceID)
+		if err != nil {
+			if isErrCode(err, 429) {
+				// Delaying retry by 30s as recommended by PagerDuty
+				// https://developer.pagerduty.com/docs/rest-api-v2/rate-limiting/#what-are-possible-workarounds-to-the-events-api-rate-limit
+				time.Sleep(30 * time.Second)
+				return resource.RetryableError(err)
+			}
+
+			return resource.NonRetryableError(err)
+		}
+
+		d.SetId(fmt.Sprintf("%s.%s", workspaceID, sourceID))
+		d.Set("workspace_id", workspaceID)
+		d.Set("connections", flattenChatConnections(connections, sourceID))
+
+		return nil
+	})
+}
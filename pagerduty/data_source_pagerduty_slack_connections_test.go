@@ -0,0 +1,98 @@
+package pagerduty
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/heimweh/go-pagerduty/pagerduty"
+)
+
+func TestDataSourcePagerDutySlackConnectionsRead(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/integration-slack/workspaces/TWORKSPACE/connections" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		if r.Header.Get("Authorization") != "Token token=bar" {
+			t.Errorf("expected the user token to be used, got %q", r.Header.Get("Authorization"))
+		}
+
+		json.NewEncoder(w).Encode(&pagerduty.ListSlackConnectionsResponse{SlackConnections: []*pagerduty.SlackConnection{
+			{ID: "A1", SourceID: "PSERVICE", SourceType: "service_reference", ChannelID: "C1", Config: pagerduty.ConnectionConfig{Events: []string{"incident.triggered"}}},
+			{ID: "A2", SourceID: "POTHER", SourceType: "service_reference", ChannelID: "C1"},
+			{ID: "A3", SourceID: "PSERVICE", SourceType: "service_reference", ChannelID: "C2"},
+		}})
+	}))
+	defer srv.Close()
+
+	d := schema.TestResourceDataRaw(t, dataSourcePagerDutySlackConnections().Schema, map[string]interface{}{
+		"source_id": "PSERVICE",
+	})
+
+	config := &Config{Token: "foo", UserToken: "bar", AppUrlOverride: srv.URL, SlackWorkspaceID: "TWORKSPACE", SkipCredsValidation: true}
+	if err := dataSourcePagerDutySlackConnectionsRead(d, config); err != nil {
+		t.Fatal(err)
+	}
+
+	if d.Id() != "TWORKSPACE.PSERVICE" || d.Get("workspace_id").(string) != "TWORKSPACE" {
+		t.Errorf("unexpected ID %q and workspace %q", d.Id(), d.Get("workspace_id"))
+	}
+	if n := d.Get("connections.#").(int); n != 2 {
+		t.Fatalf("expected 2 connections, got %d", n)
+	}
+	if id, events := d.Get("connections.0.id").(string), d.Get("connections.0.config.0.events.#").(int); id != "A1" || events != 1 {
+		t.Errorf("unexpected first connection %q with %d events", id, events)
+	}
+	if id := d.Get("connections.1.id").(string); id != "A3" {
+		t.Errorf("unexpected second connection %q", id)
+	}
+}
+
+func TestAccDataSourcePagerDutySlackConnections_Basic(t *testing.T) {
+	team := fmt.Sprintf("tf-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourcePagerDutySlackConnectionsConfig(team, channelID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.pagerduty_slack_connections.foo", "workspace_id", os.Getenv("SLACK_CONNECTION_WORKSPACE_ID")),
+					resource.TestCheckResourceAttr("data.pagerduty_slack_connections.foo", "connections.#", "1"),
+					resource.TestCheckResourceAttrPair("data.pagerduty_slack_connections.foo", "connections.0.id", "pagerduty_slack_connection.foo", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourcePagerDutySlackConnectionsConfig(team, channelID string) string {
+	return fmt.Sprintf(`
+resource "pagerduty_team" "foo" {
+  name = "%s"
+}
+
+resource "pagerduty_slack_connection" "foo" {
+  source_id         = pagerduty_team.foo.id
+  source_type       = "team_reference"
+  channel_id        = "%s"
+  notification_type = "responder"
+
+  config {
+    events     = ["incident.triggered", "incident.resolved"]
+    priorities = ["*"]
+  }
+}
+
+data "pagerduty_slack_connections" "foo" {
+  source_id = pagerduty_slack_connection.foo.source_id
+}
+`, team, channelID)
+}
@@ -0,0 +1,505 @@
+package pagerduty
+
+import (
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/heimweh/go-pagerduty/pagerduty"
+)
+
+// The sets, rules and conditions of the router, unrouted and service paths of
+// an event orchestration share one structure. Only the actions differ, so the
+// helpers below take the actions schema of the path they're used for.
+
+func eventOrchestrationPathSetSchema(ruleActions map[string]*schema.Schema) *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Required: true,
+		MinItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"id": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				"rule": {
+					Type:     schema.TypeList,
+					Optional: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"id": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							"label": {
+								Type:     schema.TypeString,
+								Optional: true,
+							},
+							"condition": {
+								Type:     schema.TypeList,
+								Optional: true,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"expression": {
+											Type:     schema.TypeString,
+											Required: true,
+										},
+									},
+								},
+							},
+							"actions": {
+								Type:     schema.TypeList,
+								Required: true,
+								MaxItems: 1,
+								Elem: &schema.Resource{
+									Schema: ruleActions,
+								},
+							},
+							"disabled": {
+								Type:     schema.TypeBool,
+								Optional: true,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func eventOrchestrationPathCatchAllSchema(actions map[string]*schema.Schema) *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Required: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"actions": {
+					Type:     schema.TypeList,
+					Required: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: actions,
+					},
+				},
+			},
+		},
+	}
+}
+
+func eventOrchestrationPathSeveritySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+		ValidateFunc: validateValueFunc([]string{
+			"info",
+			"error",
+			"warning",
+			"critical",
+		}),
+	}
+}
+
+func eventOrchestrationPathEventActionSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+		ValidateFunc: validateValueFunc([]string{
+			"trigger",
+			"resolve",
+		}),
+	}
+}
+
+func eventOrchestrationPathVariableSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				"path": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				"type": {
+					Type:     schema.TypeString,
+					Required: true,
+					ValidateFunc: validateValueFunc([]string{
+						"regex",
+					}),
+				},
+				"value": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+			},
+		},
+	}
+}
+
+func eventOrchestrationPathExtractionSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"target": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				"regex": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"source": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"template": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+			},
+		},
+	}
+}
+
+func expandEventOrchestrationPathSets(v interface{}) []*pagerduty.EventOrchestrationPathSet {
+	var sets []*pagerduty.EventOrchestrationPathSet
+
+	for _, s := range v.([]interface{}) {
+		sm := s.(map[string]interface{})
+		set := &pagerduty.EventOrchestrationPathSet{
+			ID:    sm["id"].(string),
+			Rules: []*pagerduty.EventOrchestrationPathRule{},
+		}
+
+		for _, r := range sm["rule"].([]interface{}) {
+			rm := r.(map[string]interface{})
+			set.Rules = append(set.Rules, &pagerduty.EventOrchestrationPathRule{
+				ID:         rm["id"].(string),
+				Label:      rm["label"].(string),
+				Conditions: expandEventOrchestrationPathConditions(rm["condition"]),
+				Actions:    expandEventOrchestrationPathActions(rm["actions"]),
+				Disabled:   rm["disabled"].(bool),
+			})
+		}
+
+		sets = append(sets, set)
+	}
+
+	return sets
+}
+
+func expandEventOrchestrationPathConditions(v interface{}) []*pagerduty.EventOrchestrationPathRuleCondition {
+	conditions := []*pagerduty.EventOrchestrationPathRuleCondition{}
+
+	for _, c := range v.([]interface{}) {
+		cm := c.(map[string]interface{})
+		conditions = append(conditions, &pagerduty.EventOrchestrationPathRuleCondition{
+			Expression: cm["expression"].(string),
+		})
+	}
+
+	return conditions
+}
+
+func expandEventOrchestrationPathCatchAll(v interface{}) *pagerduty.EventOrchestrationPathCatchAll {
+	catchAll := &pagerduty.EventOrchestrationPathCatchAll{
+		Actions: &pagerduty.EventOrchestrationPathRuleActions{},
+	}
+
+	for _, c := range v.([]interface{}) {
+		cm := c.(map[string]interface{})
+		catchAll.Actions = expandEventOrchestrationPathActions(cm["actions"])
+	}
+
+	return catchAll
+}
+
+// expandEventOrchestrationPathActions expands the actions of any path type;
+// keys that are not part of the path's actions schema are simply absent.
+func expandEventOrchestrationPathActions(v interface{}) *pagerduty.EventOrchestrationPathRuleActions {
+	actions := &pagerduty.EventOrchestrationPathRuleActions{}
+
+	for _, a := range v.([]interface{}) {
+		if a == nil {
+			continue
+		}
+		am := a.(map[string]interface{})
+
+		if v, ok := am["route_to"]; ok {
+			actions.RouteTo = v.(string)
+		}
+		if v, ok := am["suppress"]; ok {
+			actions.Suppress = v.(bool)
+		}
+		if v, ok := am["suspend"]; ok && v.(int) > 0 {
+			suspend := v.(int)
+			actions.Suspend = &suspend
+		}
+		if v, ok := am["priority"]; ok {
+			actions.Priority = v.(string)
+		}
+		if v, ok := am["annotate"]; ok {
+			actions.Annotate = v.(string)
+		}
+		if v, ok := am["severity"]; ok {
+			actions.Severity = v.(string)
+		}
+		if v, ok := am["event_action"]; ok {
+			actions.EventAction = v.(string)
+		}
+		if v, ok := am["pagerduty_automation_action"]; ok {
+			for _, p := range v.([]interface{}) {
+				pm := p.(map[string]interface{})
+				actions.PagerdutyAutomationActions = append(actions.PagerdutyAutomationActions, &pagerduty.EventOrchestrationPathPagerdutyAutomationAction{
+					ActionID: pm["action_id"].(string),
+				})
+			}
+		}
+		if v, ok := am["automation_action"]; ok {
+			for _, w := range v.([]interface{}) {
+				wm := w.(map[string]interface{})
+				actions.AutomationActions = append(actions.AutomationActions, &pagerduty.EventOrchestrationPathAutomationAction{
+					Name:       wm["name"].(string),
+					Url:        wm["url"].(string),
+					AutoSend:   wm["auto_send"].(bool),
+					Headers:    expandEventOrchestrationPathAutomationActionObjects(wm["header"]),
+					Parameters: expandEventOrchestrationPathAutomationActionObjects(wm["parameter"]),
+				})
+			}
+		}
+		if v, ok := am["variable"]; ok {
+			for _, r := range v.([]interface{}) {
+				rm := r.(map[string]interface{})
+				actions.Variables = append(actions.Variables, &pagerduty.EventOrchestrationPathActionVariables{
+					Name:  rm["name"].(string),
+					Path:  rm["path"].(string),
+					Type:  rm["type"].(string),
+					Value: rm["value"].(string),
+				})
+			}
+		}
+		if v, ok := am["extraction"]; ok {
+			for _, e := range v.([]interface{}) {
+				em := e.(map[string]interface{})
+				actions.Extractions = append(actions.Extractions, &pagerduty.EventOrchestrationPathActionExtractions{
+					Target:   em["target"].(string),
+					Regex:    em["regex"].(string),
+					Source:   em["source"].(string),
+					Template: em["template"].(string),
+				})
+			}
+		}
+	}
+
+	return actions
+}
+
+func expandEventOrchestrationPathAutomationActionObjects(v interface{}) []*pagerduty.EventOrchestrationPathAutomationActionObject {
+	var objects []*pagerduty.EventOrchestrationPathAutomationActionObject
+
+	for _, o := range v.([]interface{}) {
+		om := o.(map[string]interface{})
+		objects = append(objects, &pagerduty.EventOrchestrationPathAutomationActionObject{
+			Key:   om["key"].(string),
+			Value: om["value"].(string),
+		})
+	}
+
+	return objects
+}
+
+func flattenEventOrchestrationPathSets(sets []*pagerduty.EventOrchestrationPathSet, ruleActions map[string]*schema.Schema) []interface{} {
+	var flattened []interface{}
+
+	for _, s := range sets {
+		var rules []interface{}
+		for _, r := range s.Rules {
+			var conditions []interface{}
+			for _, c := range r.Conditions {
+				conditions = append(conditions, map[string]interface{}{
+					"expression": c.Expression,
+				})
+			}
+
+			rules = append(rules, map[string]interface{}{
+				"id":        r.ID,
+				"label":     r.Label,
+				"condition": conditions,
+				"actions":   flattenEventOrchestrationPathActions(r.Actions, ruleActions),
+				"disabled":  r.Disabled,
+			})
+		}
+
+		flattened = append(flattened, map[string]interface{}{
+			"id":   s.ID,
+			"rule": rules,
+		})
+	}
+
+	return flattened
+}
+
+func flattenEventOrchestrationPathCatchAll(catchAll *pagerduty.EventOrchestrationPathCatchAll, actions map[string]*schema.Schema) []interface{} {
+	if catchAll == nil {
+		return nil
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"actions": flattenEventOrchestrationPathActions(catchAll.Actions, actions),
+		},
+	}
+}
+
+// flattenEventOrchestrationPathActions only returns the keys that are part of
+// the given actions schema, since the API returns every action for every path.
+func flattenEventOrchestrationPathActions(a *pagerduty.EventOrchestrationPathRuleActions, actions map[string]*schema.Schema) []interface{} {
+	if a == nil {
+		return nil
+	}
+
+	suspend := 0
+	if a.Suspend != nil {
+		suspend = *a.Suspend
+	}
+
+	var pagerdutyAutomationActions []interface{}
+	for _, p := range a.PagerdutyAutomationActions {
+		pagerdutyAutomationActions = append(pagerdutyAutomationActions, map[string]interface{}{
+			"action_id": p.ActionID,
+		})
+	}
+
+	var automationActions []interface{}
+	for _, w := range a.AutomationActions {
+		automationActions = append(automationActions, map[string]interface{}{
+			"name":      w.Name,
+			"url":       w.Url,
+			"auto_send": w.AutoSend,
+			"header":    flattenEventOrchestrationPathAutomationActionObjects(w.Headers),
+			"parameter": flattenEventOrchestrationPathAutomationActionObjects(w.Parameters),
+		})
+	}
+
+	var variables []interface{}
+	for _, v := range a.Variables {
+		variables = append(variables, map[string]interface{}{
+			"name":  v.Name,
+			"path":  v.Path,
+			"type":  v.Type,
+			"value": v.Value,
+		})
+	}
+
+	var extractions []interface{}
+	for _, e := range a.Extractions {
+		extractions = append(extractions, map[string]interface{}{
+			"target":   e.Target,
+			"regex":    e.Regex,
+			"source":   e.Source,
+			"template": e.Template,
+		})
+	}
+
+	all := map[string]interface{}{
+		"route_to":                    a.RouteTo,
+		"suppress":                    a.Suppress,
+		"suspend":                     suspend,
+		"priority":                    a.Priority,
+		"annotate":                    a.Annotate,
+		"severity":                    a.Severity,
+		"event_action":                a.EventAction,
+		"pagerduty_automation_action": pagerdutyAutomationActions,
+		"automation_action":           automationActions,
+		"variable":                    variables,
+		"extraction":                  extractions,
+	}
+
+	flattened := make(map[string]interface{})
+	for k := range actions {
+		flattened[k] = all[k]
+	}
+
+	return []interface{}{flattened}
+}
+
+func flattenEventOrchestrationPathAutomationActionObjects(objects []*pagerduty.EventOrchestrationPathAutomationActionObject) []interface{} {
+	var flattened []interface{}
+
+	for _, o := range objects {
+		flattened = append(flattened, map[string]interface{}{
+			"key":   o.Key,
+			"value": o.Value,
+		})
+	}
+
+	return flattened
+}
+
+// fetchEventOrchestrationPath reads the path of type pathType belonging to
+// the resource's ID into sets and catch_all.
+func fetchEventOrchestrationPath(d *schema.ResourceData, meta interface{}, pathType string, ruleActions, catchAllActions map[string]*schema.Schema, errCallback func(error, *schema.ResourceData) error) error {
+	client, _ := meta.(*Config).Client()
+
+	return resource.Retry(2*time.Minute, func() *resource.RetryError {
+		path, _, err := client.EventOrchestrations.GetPath(d.Id(), pathType)
+		if err != nil {
+			errResp := errCallback(err, d)
+			if errResp != nil {
+				time.Sleep(2 * time.Second)
+				return resource.RetryableError(errResp)
+			}
+
+			return nil
+		}
+
+		if err := d.Set("set", flattenEventOrchestrationPathSets(path.Sets, ruleActions)); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		if err := d.Set("catch_all", flattenEventOrchestrationPathCatchAll(path.CatchAll, catchAllActions)); err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+}
+
+// updateEventOrchestrationPath replaces the sets and catch-all of a path.
+func updateEventOrchestrationPath(client *pagerduty.Client, id, pathType string, path *pagerduty.EventOrchestrationPath) error {
+	log.Printf("[INFO] Updating PagerDuty event orchestration %s path of %s", pathType, id)
+
+	return resource.Retry(30*time.Second, func() *resource.RetryError {
+		if _, _, err := client.EventOrchestrations.UpdatePath(id, pathType, path); err != nil {
+			if isErrCode(err, 429) {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+}
+
+// emptyEventOrchestrationPath returns a path without any rules. Paths can't be
+// deleted, so this is what destroying a path resource writes.
+func emptyEventOrchestrationPath(catchAll *pagerduty.EventOrchestrationPathRuleActions) *pagerduty.EventOrchestrationPath {
+	return &pagerduty.EventOrchestrationPath{
+		Sets: []*pagerduty.EventOrchestrationPathSet{
+			{
+				ID:    "start",
+				Rules: []*pagerduty.EventOrchestrationPathRule{},
+			},
+		},
+		CatchAll: &pagerduty.EventOrchestrationPathCatchAll{
+			Actions: catchAll,
+		},
+	}
+}
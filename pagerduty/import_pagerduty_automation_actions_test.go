@@ -0,0 +1,100 @@
+package pagerduty
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccPagerDutyAutomationActionsRunner_import(t *testing.T) {
+	name := fmt.Sprintf("tf-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPagerDutyAutomationActionsRunnerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckPagerDutyAutomationActionsRunnerConfig(name, "foo"),
+			},
+
+			{
+				ResourceName:      "pagerduty_automation_actions_runner.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// The API key is never returned by the API.
+				ImportStateVerifyIgnore: []string{"runbook_api_key"},
+			},
+		},
+	})
+}
+
+func TestAccPagerDutyAutomationActionsAction_import(t *testing.T) {
+	name := fmt.Sprintf("tf-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPagerDutyAutomationActionsActionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckPagerDutyAutomationActionsActionConfig(name, "echo 'hello'"),
+			},
+
+			{
+				ResourceName:      "pagerduty_automation_actions_action.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccPagerDutyAutomationActionsActionServiceAssociation_import(t *testing.T) {
+	action := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	service := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	username := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	email := fmt.Sprintf("%s@foo.com", username)
+	escalationPolicy := fmt.Sprintf("tf-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPagerDutyAutomationActionsActionServiceAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckPagerDutyAutomationActionsActionServiceAssociationConfig(action, service, username, email, escalationPolicy),
+			},
+
+			{
+				ResourceName:      "pagerduty_automation_actions_action_service_association.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccPagerDutyAutomationActionsActionTeamAssociation_import(t *testing.T) {
+	action := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	team := fmt.Sprintf("tf-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPagerDutyAutomationActionsActionTeamAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckPagerDutyAutomationActionsActionTeamAssociationConfig(action, team),
+			},
+
+			{
+				ResourceName:      "pagerduty_automation_actions_action_team_association.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
@@ -0,0 +1,57 @@
+package pagerduty
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccPagerDutyIncidentCustomField_import(t *testing.T) {
+	name := fmt.Sprintf("tf_%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPagerDutyIncidentCustomFieldDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckPagerDutyIncidentCustomFieldConfig(name, "Customer impact", "foo"),
+			},
+
+			{
+				ResourceName:      "pagerduty_incident_custom_field.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccPagerDutyIncidentCustomFieldOption_import(t *testing.T) {
+	name := fmt.Sprintf("tf_%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPagerDutyIncidentCustomFieldOptionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckPagerDutyIncidentCustomFieldOptionConfig(name, "high"),
+			},
+
+			{
+				ResourceName:      "pagerduty_incident_custom_field_option.foo",
+				ImportStateIdFunc: testAccCheckPagerDutyIncidentCustomFieldOptionID,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckPagerDutyIncidentCustomFieldOptionID(s *terraform.State) (string, error) {
+	return fmt.Sprintf("%v.%v", s.RootModule().Resources["pagerduty_incident_custom_field.foo"].Primary.ID, s.RootModule().Resources["pagerduty_incident_custom_field_option.foo"].Primary.ID), nil
+}
@@ -0,0 +1,63 @@
+package pagerduty
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccPagerDutyIncidentWorkflow_import(t *testing.T) {
+	name := fmt.Sprintf("tf-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPagerDutyIncidentWorkflowDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckPagerDutyIncidentWorkflowConfig(name),
+			},
+
+			{
+				ResourceName:      "pagerduty_incident_workflow.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// Imported steps include every input of their action.
+				ImportStateVerifyIgnore: []string{"step.0.input"},
+			},
+		},
+	})
+}
+
+func TestAccPagerDutyIncidentWorkflowTrigger_import(t *testing.T) {
+	workflow := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	service := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	username := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	email := fmt.Sprintf("%s@foo.com", username)
+	escalationPolicy := fmt.Sprintf("tf-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPagerDutyIncidentWorkflowTriggerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckPagerDutyIncidentWorkflowTriggerConfig(workflow, service, username, email, escalationPolicy),
+			},
+
+			{
+				ResourceName:      "pagerduty_incident_workflow_trigger.manual",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+
+			{
+				ResourceName:      "pagerduty_incident_workflow_trigger.conditional",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
@@ -1,13 +1,55 @@
 package pagerduty
 
 import (
+	"context"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
+func TestPagerDutyRuleset_ImportDeletionProtection(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/rulesets/PRULESET" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Write([]byte(`{"ruleset":{"id":"PRULESET","name":"foo","type":"global"}}`))
+	}))
+	defer srv.Close()
+
+	config := &Config{Token: "foo", ApiUrlOverride: srv.URL, SkipCredsValidation: true}
+
+	d := resourcePagerDutyRuleset().TestResourceData()
+	d.SetId("PRULESET")
+	if _, err := resourcePagerDutyRuleset().Importer.State(d, config); err != nil {
+		t.Fatal(err)
+	}
+	if err := resourcePagerDutyRuleset().Read(d, config); err != nil {
+		t.Fatal(err)
+	}
+
+	state := d.State()
+	if v, ok := state.Attributes["deletion_protection"]; !ok || v != "false" {
+		t.Fatalf("expected deletion_protection to be false after an import, got %q", v)
+	}
+
+	diff, err := resourcePagerDutyRuleset().Diff(context.Background(), state, terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name": "foo",
+	}), config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff != nil && diff.Attributes["deletion_protection"] != nil {
+		t.Errorf("expected no deletion_protection diff after an import, got %v", diff.Attributes["deletion_protection"])
+	}
+}
+
 func TestAccPagerDutyRuleset_import(t *testing.T) {
 	ruleset := fmt.Sprintf("tf-%s", acctest.RandString(5))
 	teamName := fmt.Sprintf("tf-%s", acctest.RandString(5))
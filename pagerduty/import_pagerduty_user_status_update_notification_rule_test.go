@@ -0,0 +1,36 @@
+package pagerduty
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccPagerDutyUserStatusUpdateNotificationRule_import(t *testing.T) {
+	username := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	email := fmt.Sprintf("%s@foo.com", username)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPagerDutyUserStatusUpdateNotificationRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckPagerDutyUserStatusUpdateNotificationRuleConfig("email_contact_method", username, email),
+			},
+			{
+				ResourceName:      "pagerduty_user_status_update_notification_rule.foo",
+				ImportStateIdFunc: testAccCheckPagerDutyUserStatusUpdateNotificationRuleId,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckPagerDutyUserStatusUpdateNotificationRuleId(s *terraform.State) (string, error) {
+	return fmt.Sprintf("%v:%v", s.RootModule().Resources["pagerduty_user.foo"].Primary.ID, s.RootModule().Resources["pagerduty_user_status_update_notification_rule.foo"].Primary.ID), nil
+}
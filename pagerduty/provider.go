@@ -3,6 +3,7 @@ package pagerduty
 import (
 	"fmt"
 	"log"
+	"os"
 	"runtime"
 	"strings"
 
@@ -43,50 +44,110 @@ func Provider() *schema.Provider {
 				Optional: true,
 				Default:  "",
 			},
+
+			"events_url_override": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "",
+			},
+
+			"default_from_email": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("PAGERDUTY_DEFAULT_FROM_EMAIL", ""),
+			},
+
+			"slack": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"workspace_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"user_token": {
+							Type:      schema.TypeString,
+							Optional:  true,
+							Sensitive: true,
+						},
+						"app_url_override": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
-			"pagerduty_escalation_policy":   dataSourcePagerDutyEscalationPolicy(),
-			"pagerduty_schedule":            dataSourcePagerDutySchedule(),
-			"pagerduty_user":                dataSourcePagerDutyUser(),
-			"pagerduty_user_contact_method": dataSourcePagerDutyUserContactMethod(),
-			"pagerduty_team":                dataSourcePagerDutyTeam(),
-			"pagerduty_vendor":              dataSourcePagerDutyVendor(),
-			"pagerduty_extension_schema":    dataSourcePagerDutyExtensionSchema(),
-			"pagerduty_service":             dataSourcePagerDutyService(),
-			"pagerduty_service_integration": dataSourcePagerDutyServiceIntegration(),
-			"pagerduty_business_service":    dataSourcePagerDutyBusinessService(),
-			"pagerduty_priority":            dataSourcePagerDutyPriority(),
-			"pagerduty_ruleset":             dataSourcePagerDutyRuleset(),
-			"pagerduty_tag":                 dataSourcePagerDutyTag(),
+			"pagerduty_escalation_policy":       dataSourcePagerDutyEscalationPolicy(),
+			"pagerduty_schedule":                dataSourcePagerDutySchedule(),
+			"pagerduty_user":                    dataSourcePagerDutyUser(),
+			"pagerduty_user_contact_method":     dataSourcePagerDutyUserContactMethod(),
+			"pagerduty_team":                    dataSourcePagerDutyTeam(),
+			"pagerduty_vendor":                  dataSourcePagerDutyVendor(),
+			"pagerduty_extension_schema":        dataSourcePagerDutyExtensionSchema(),
+			"pagerduty_service":                 dataSourcePagerDutyService(),
+			"pagerduty_service_integration":     dataSourcePagerDutyServiceIntegration(),
+			"pagerduty_business_service":        dataSourcePagerDutyBusinessService(),
+			"pagerduty_priority":                dataSourcePagerDutyPriority(),
+			"pagerduty_ruleset":                 dataSourcePagerDutyRuleset(),
+			"pagerduty_tag":                     dataSourcePagerDutyTag(),
+			"pagerduty_event_orchestration":     dataSourcePagerDutyEventOrchestration(),
+			"pagerduty_incident_workflow":       dataSourcePagerDutyIncidentWorkflow(),
+			"pagerduty_incident_custom_field":   dataSourcePagerDutyIncidentCustomField(),
+			"pagerduty_slack_connections":       dataSourcePagerDutySlackConnections(),
+			"pagerduty_business_service_impact": dataSourcePagerDutyBusinessServiceImpact(),
 		},
 
 		ResourcesMap: map[string]*schema.Resource{
-			"pagerduty_addon":                       resourcePagerDutyAddon(),
-			"pagerduty_escalation_policy":           resourcePagerDutyEscalationPolicy(),
-			"pagerduty_maintenance_window":          resourcePagerDutyMaintenanceWindow(),
-			"pagerduty_schedule":                    resourcePagerDutySchedule(),
-			"pagerduty_service":                     resourcePagerDutyService(),
-			"pagerduty_service_integration":         resourcePagerDutyServiceIntegration(),
-			"pagerduty_team":                        resourcePagerDutyTeam(),
-			"pagerduty_team_membership":             resourcePagerDutyTeamMembership(),
-			"pagerduty_user":                        resourcePagerDutyUser(),
-			"pagerduty_user_contact_method":         resourcePagerDutyUserContactMethod(),
-			"pagerduty_user_notification_rule":      resourcePagerDutyUserNotificationRule(),
-			"pagerduty_extension":                   resourcePagerDutyExtension(),
-			"pagerduty_extension_servicenow":        resourcePagerDutyExtensionServiceNow(),
-			"pagerduty_event_rule":                  resourcePagerDutyEventRule(),
-			"pagerduty_ruleset":                     resourcePagerDutyRuleset(),
-			"pagerduty_ruleset_rule":                resourcePagerDutyRulesetRule(),
-			"pagerduty_business_service":            resourcePagerDutyBusinessService(),
-			"pagerduty_service_dependency":          resourcePagerDutyServiceDependency(),
-			"pagerduty_response_play":               resourcePagerDutyResponsePlay(),
-			"pagerduty_tag":                         resourcePagerDutyTag(),
-			"pagerduty_tag_assignment":              resourcePagerDutyTagAssignment(),
-			"pagerduty_service_event_rule":          resourcePagerDutyServiceEventRule(),
-			"pagerduty_slack_connection":            resourcePagerDutySlackConnection(),
-			"pagerduty_business_service_subscriber": resourcePagerDutyBusinessServiceSubscriber(),
-			"pagerduty_webhook_subscription":        resourcePagerDutyWebhookSubscription(),
+			"pagerduty_addon":                                         resourcePagerDutyAddon(),
+			"pagerduty_escalation_policy":                             resourcePagerDutyEscalationPolicy(),
+			"pagerduty_maintenance_window":                            resourcePagerDutyMaintenanceWindow(),
+			"pagerduty_schedule":                                      resourcePagerDutySchedule(),
+			"pagerduty_service":                                       resourcePagerDutyService(),
+			"pagerduty_service_integration":                           resourcePagerDutyServiceIntegration(),
+			"pagerduty_team":                                          resourcePagerDutyTeam(),
+			"pagerduty_team_membership":                               resourcePagerDutyTeamMembership(),
+			"pagerduty_user":                                          resourcePagerDutyUser(),
+			"pagerduty_user_contact_method":                           resourcePagerDutyUserContactMethod(),
+			"pagerduty_user_notification_rule":                        resourcePagerDutyUserNotificationRule(),
+			"pagerduty_user_handoff_notification_rule":                resourcePagerDutyUserHandoffNotificationRule(),
+			"pagerduty_user_status_update_notification_rule":          resourcePagerDutyUserStatusUpdateNotificationRule(),
+			"pagerduty_extension":                                     resourcePagerDutyExtension(),
+			"pagerduty_extension_servicenow":                          resourcePagerDutyExtensionServiceNow(),
+			"pagerduty_event_rule":                                    resourcePagerDutyEventRule(),
+			"pagerduty_ruleset":                                       resourcePagerDutyRuleset(),
+			"pagerduty_ruleset_rule":                                  resourcePagerDutyRulesetRule(),
+			"pagerduty_business_service":                              resourcePagerDutyBusinessService(),
+			"pagerduty_service_dependency":                            resourcePagerDutyServiceDependency(),
+			"pagerduty_service_dependencies":                          resourcePagerDutyServiceDependencies(),
+			"pagerduty_response_play":                                 resourcePagerDutyResponsePlay(),
+			"pagerduty_tag":                                           resourcePagerDutyTag(),
+			"pagerduty_tag_assignment":                                resourcePagerDutyTagAssignment(),
+			"pagerduty_service_event_rule":                            resourcePagerDutyServiceEventRule(),
+			"pagerduty_slack_connection":                              resourcePagerDutySlackConnection(),
+			"pagerduty_business_service_subscriber":                   resourcePagerDutyBusinessServiceSubscriber(),
+			"pagerduty_webhook_subscription":                          resourcePagerDutyWebhookSubscription(),
+			"pagerduty_event_orchestration":                           resourcePagerDutyEventOrchestration(),
+			"pagerduty_event_orchestration_router":                    resourcePagerDutyEventOrchestrationRouter(),
+			"pagerduty_event_orchestration_unrouted":                  resourcePagerDutyEventOrchestrationUnrouted(),
+			"pagerduty_event_orchestration_service":                   resourcePagerDutyEventOrchestrationService(),
+			"pagerduty_incident_workflow":                             resourcePagerDutyIncidentWorkflow(),
+			"pagerduty_incident_workflow_trigger":                     resourcePagerDutyIncidentWorkflowTrigger(),
+			"pagerduty_incident_custom_field":                         resourcePagerDutyIncidentCustomField(),
+			"pagerduty_incident_custom_field_option":                  resourcePagerDutyIncidentCustomFieldOption(),
+			"pagerduty_automation_actions_runner":                     resourcePagerDutyAutomationActionsRunner(),
+			"pagerduty_automation_actions_action":                     resourcePagerDutyAutomationActionsAction(),
+			"pagerduty_automation_actions_action_service_association": resourcePagerDutyAutomationActionsActionServiceAssociation(),
+			"pagerduty_automation_actions_action_team_association":    resourcePagerDutyAutomationActionsActionTeamAssociation(),
+			"pagerduty_change_event":                                  resourcePagerDutyChangeEvent(),
+			"pagerduty_recurring_maintenance_window":                  resourcePagerDutyRecurringMaintenanceWindow(),
+			"pagerduty_user_notification_profile":                     resourcePagerDutyUserNotificationProfile(),
+			"pagerduty_msteams_connection":                            resourcePagerDutyMSTeamsConnection(),
+			"pagerduty_business_service_priority_threshold":           resourcePagerDutyBusinessServicePriorityThreshold(),
 		},
 	}
 
@@ -136,11 +197,35 @@ func providerConfigure(data *schema.ResourceData, terraformVersion string) (inte
 	config := Config{
 		ApiUrl:              "https://api." + ServiceRegion + "pagerduty.com",
 		AppUrl:              "https://app." + ServiceRegion + "pagerduty.com",
+		EventsUrl:           "https://events." + ServiceRegion + "pagerduty.com",
 		SkipCredsValidation: data.Get("skip_credentials_validation").(bool),
 		Token:               data.Get("token").(string),
 		UserToken:           data.Get("user_token").(string),
 		UserAgent:           fmt.Sprintf("(%s %s) Terraform/%s", runtime.GOOS, runtime.GOARCH, terraformVersion),
 		ApiUrlOverride:      data.Get("api_url_override").(string),
+		EventsUrlOverride:   data.Get("events_url_override").(string),
+		SlackWorkspaceID:    os.Getenv("SLACK_CONNECTION_WORKSPACE_ID"),
+		DefaultFromEmail:    data.Get("default_from_email").(string),
+
+		serviceDependencyGraph:     newServiceDependencyGraph(),
+		userNotificationRulePlan:   newUserNotificationRulePlan(),
+		responsePlayReferenceNames: newResponsePlayReferenceNames(),
+	}
+
+	// The slack block takes precedence over the top level user_token and the
+	// SLACK_CONNECTION_WORKSPACE_ID environment variable.
+	for _, v := range data.Get("slack").([]interface{}) {
+		slack, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if workspaceID := slack["workspace_id"].(string); workspaceID != "" {
+			config.SlackWorkspaceID = workspaceID
+		}
+		if userToken := slack["user_token"].(string); userToken != "" {
+			config.UserToken = userToken
+		}
+		config.AppUrlOverride = slack["app_url_override"].(string)
 	}
 
 	log.Println("[INFO] Initializing PagerDuty client")
@@ -29,6 +29,42 @@ func TestProviderImpl(t *testing.T) {
 	var _ *schema.Provider = Provider()
 }
 
+func TestProviderConfigureSlack(t *testing.T) {
+	os.Setenv("SLACK_CONNECTION_WORKSPACE_ID", "TENV")
+	defer os.Unsetenv("SLACK_CONNECTION_WORKSPACE_ID")
+
+	d := schema.TestResourceDataRaw(t, Provider().Schema, map[string]interface{}{
+		"token":      "foo",
+		"user_token": "bar",
+	})
+	meta, err := providerConfigure(d, "0.12")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if config := meta.(*Config); config.SlackWorkspaceID != "TENV" || config.UserToken != "bar" || config.AppUrlOverride != "" {
+		t.Errorf("unexpected Slack settings %q, %q, %q", config.SlackWorkspaceID, config.UserToken, config.AppUrlOverride)
+	}
+
+	d = schema.TestResourceDataRaw(t, Provider().Schema, map[string]interface{}{
+		"token":      "foo",
+		"user_token": "bar",
+		"slack": []interface{}{
+			map[string]interface{}{
+				"workspace_id":     "TWORKSPACE",
+				"user_token":       "baz",
+				"app_url_override": "http://localhost:8080",
+			},
+		},
+	})
+	meta, err = providerConfigure(d, "0.12")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if config := meta.(*Config); config.SlackWorkspaceID != "TWORKSPACE" || config.UserToken != "baz" || config.AppUrlOverride != "http://localhost:8080" {
+		t.Errorf("unexpected Slack settings %q, %q, %q", config.SlackWorkspaceID, config.UserToken, config.AppUrlOverride)
+	}
+}
+
 func testAccPreCheck(t *testing.T) {
 	if v := os.Getenv("PAGERDUTY_PARALLEL"); v != "" {
 		t.Parallel()
@@ -0,0 +1,378 @@
+package pagerduty
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/heimweh/go-pagerduty/pagerduty"
+)
+
+func resourcePagerDutyAutomationActionsAction() *schema.Resource {
+	return &schema.Resource{
+		Create:        resourcePagerDutyAutomationActionsActionCreate,
+		Read:          resourcePagerDutyAutomationActionsActionRead,
+		Update:        resourcePagerDutyAutomationActionsActionUpdate,
+		Delete:        resourcePagerDutyAutomationActionsActionDelete,
+		CustomizeDiff: resourcePagerDutyAutomationActionsActionCustomizeDiff,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"action_type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validateValueFunc([]string{
+					"script",
+					"process_automation",
+				}),
+			},
+			"runner_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"action_classification": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validateValueFunc([]string{
+					"diagnostic",
+					"remediation",
+				}),
+			},
+			"action_data_reference": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"process_automation_job_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"process_automation_job_arguments": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"process_automation_node_filter": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"script": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"invocation_command": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"runner_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"creation_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"modify_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+var automationActionsActionDataFields = map[string][]string{
+	"script": {
+		"script",
+		"invocation_command",
+	},
+	"process_automation": {
+		"process_automation_job_id",
+		"process_automation_job_arguments",
+		"process_automation_node_filter",
+	},
+}
+
+// validateAutomationActionsAction checks that the action_data_reference of an
+// action only uses the fields of its action type. Script actions need a
+// script, process automation actions need a job and a runner to run it on.
+func validateAutomationActionsAction(actionType string, runnerSet bool, data map[string]interface{}) error {
+	if _, ok := automationActionsActionDataFields[actionType]; !ok {
+		return nil
+	}
+
+	for otherType, fields := range automationActionsActionDataFields {
+		if otherType == actionType {
+			continue
+		}
+		for _, f := range fields {
+			if v, _ := data[f].(string); v != "" {
+				return fmt.Errorf("action_data_reference.0.%s cannot be set for a %s action", f, actionType)
+			}
+		}
+	}
+
+	switch actionType {
+	case "script":
+		if v, _ := data["script"].(string); v == "" {
+			return fmt.Errorf("action_data_reference.0.script must be set for a script action")
+		}
+	case "process_automation":
+		if v, _ := data["process_automation_job_id"].(string); v == "" {
+			return fmt.Errorf("action_data_reference.0.process_automation_job_id must be set for a process_automation action")
+		}
+		if !runnerSet {
+			return fmt.Errorf("runner_id must be set for a process_automation action")
+		}
+	}
+
+	return nil
+}
+
+// automationActionsRunnerTypes maps the action types to the type of runner
+// their actions run on.
+var automationActionsRunnerTypes = map[string]string{
+	"script":             "sidecar",
+	"process_automation": "runbook",
+}
+
+func resourcePagerDutyAutomationActionsActionCustomizeDiff(context context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	data := make(map[string]interface{})
+	if refs := diff.Get("action_data_reference").([]interface{}); len(refs) > 0 && refs[0] != nil {
+		for k, v := range refs[0].(map[string]interface{}) {
+			data[k] = v
+			// Fields only known after apply will be set.
+			if !diff.NewValueKnown("action_data_reference.0." + k) {
+				data[k] = "unknown"
+			}
+		}
+	}
+
+	actionType := diff.Get("action_type").(string)
+	runnerID := diff.Get("runner_id").(string)
+
+	// The runner is usually created in the same apply.
+	runnerKnown := diff.NewValueKnown("runner_id")
+	runnerSet := runnerID != "" || !runnerKnown
+
+	if err := validateAutomationActionsAction(actionType, runnerSet, data); err != nil {
+		return err
+	}
+
+	// A runner created in the same apply is checked by the API instead.
+	if !runnerKnown || runnerID == "" {
+		return nil
+	}
+	if diff.Id() != "" && !diff.HasChange("runner_id") && !diff.HasChange("action_type") {
+		return nil
+	}
+
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return err
+	}
+
+	return validateAutomationActionsActionRunner(client, actionType, runnerID)
+}
+
+// validateAutomationActionsActionRunner checks that an action runs on a runner
+// of the type its action type needs.
+func validateAutomationActionsActionRunner(client *pagerduty.Client, actionType, runnerID string) error {
+	want, ok := automationActionsRunnerTypes[actionType]
+	if !ok {
+		return nil
+	}
+
+	runner, _, err := client.AutomationActions.GetRunner(runnerID)
+	if err != nil {
+		if isErrCode(err, 404) {
+			return fmt.Errorf("runner_id %s does not exist", runnerID)
+		}
+		return err
+	}
+
+	if runner.RunnerType != want {
+		return fmt.Errorf("a %s action must run on a %s runner, but runner_id %s is a %s runner", actionType, want, runnerID, runner.RunnerType)
+	}
+
+	return nil
+}
+
+func buildAutomationActionsActionStruct(d *schema.ResourceData) *pagerduty.AutomationActionsAction {
+	description := d.Get("description").(string)
+
+	action := &pagerduty.AutomationActionsAction{
+		Name:        d.Get("name").(string),
+		Description: &description,
+		ActionType:  d.Get("action_type").(string),
+		RunnerID:    d.Get("runner_id").(string),
+	}
+
+	if attr, ok := d.GetOk("action_classification"); ok {
+		classification := attr.(string)
+		action.ActionClassification = &classification
+	}
+
+	if refs := d.Get("action_data_reference").([]interface{}); len(refs) > 0 && refs[0] != nil {
+		ref := refs[0].(map[string]interface{})
+		action.ActionDataReference = &pagerduty.AutomationActionsActionDataReference{
+			ProcessAutomationJobID:        ref["process_automation_job_id"].(string),
+			ProcessAutomationJobArguments: ref["process_automation_job_arguments"].(string),
+			ProcessAutomationNodeFilter:   ref["process_automation_node_filter"].(string),
+			Script:                        ref["script"].(string),
+			InvocationCommand:             ref["invocation_command"].(string),
+		}
+	}
+
+	return action
+}
+
+func flattenAutomationActionsActionDataReference(ref *pagerduty.AutomationActionsActionDataReference) []interface{} {
+	if ref == nil {
+		return nil
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"process_automation_job_id":        ref.ProcessAutomationJobID,
+			"process_automation_job_arguments": ref.ProcessAutomationJobArguments,
+			"process_automation_node_filter":   ref.ProcessAutomationNodeFilter,
+			"script":                           ref.Script,
+			"invocation_command":               ref.InvocationCommand,
+		},
+	}
+}
+
+func fetchPagerDutyAutomationActionsAction(d *schema.ResourceData, meta interface{}, errCallback func(error, *schema.ResourceData) error) error {
+	client, _ := meta.(*Config).Client()
+
+	return resource.Retry(2*time.Minute, func() *resource.RetryError {
+		action, _, err := client.AutomationActions.GetAction(d.Id())
+		if err != nil {
+			errResp := errCallback(err, d)
+			if errResp != nil {
+				time.Sleep(2 * time.Second)
+				return resource.RetryableError(errResp)
+			}
+
+			return nil
+		}
+
+		d.Set("name", action.Name)
+		d.Set("action_type", action.ActionType)
+		d.Set("runner_id", action.RunnerID)
+		d.Set("runner_type", action.RunnerType)
+		d.Set("type", action.Type)
+		d.Set("creation_time", action.CreationTime)
+		d.Set("modify_time", action.ModifyTime)
+
+		if action.Description != nil {
+			d.Set("description", *action.Description)
+		} else {
+			d.Set("description", "")
+		}
+
+		if action.ActionClassification != nil {
+			d.Set("action_classification", *action.ActionClassification)
+		} else {
+			d.Set("action_classification", "")
+		}
+
+		if err := d.Set("action_data_reference", flattenAutomationActionsActionDataReference(action.ActionDataReference)); err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+}
+
+func resourcePagerDutyAutomationActionsActionCreate(d *schema.ResourceData, meta interface{}) error {
+	client, _ := meta.(*Config).Client()
+
+	action := buildAutomationActionsActionStruct(d)
+
+	log.Printf("[INFO] Creating PagerDuty automation action: %s", action.Name)
+
+	retryErr := resource.Retry(10*time.Second, func() *resource.RetryError {
+		if action, _, err := client.AutomationActions.CreateAction(action); err != nil {
+			if isErrCode(err, 429) {
+				return resource.RetryableError(err)
+			}
+
+			return resource.NonRetryableError(err)
+		} else if action != nil {
+			d.SetId(action.ID)
+		}
+		return nil
+	})
+
+	if retryErr != nil {
+		return retryErr
+	}
+
+	return fetchPagerDutyAutomationActionsAction(d, meta, genError)
+}
+
+func resourcePagerDutyAutomationActionsActionRead(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[INFO] Reading PagerDuty automation action: %s", d.Id())
+	return fetchPagerDutyAutomationActionsAction(d, meta, handleNotFoundError)
+}
+
+func resourcePagerDutyAutomationActionsActionUpdate(d *schema.ResourceData, meta interface{}) error {
+	client, _ := meta.(*Config).Client()
+
+	action := buildAutomationActionsActionStruct(d)
+
+	log.Printf("[INFO] Updating PagerDuty automation action: %s", d.Id())
+
+	if _, _, err := client.AutomationActions.UpdateAction(d.Id(), action); err != nil {
+		return err
+	}
+
+	return fetchPagerDutyAutomationActionsAction(d, meta, genError)
+}
+
+func resourcePagerDutyAutomationActionsActionDelete(d *schema.ResourceData, meta interface{}) error {
+	client, _ := meta.(*Config).Client()
+
+	log.Printf("[INFO] Deleting PagerDuty automation action: %s", d.Id())
+
+	if _, err := client.AutomationActions.DeleteAction(d.Id()); err != nil {
+		return err
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+// parseAutomationActionsAssociationID splits the ID of a service or team
+// association, "<action_id>:<service_or_team_id>".
+func parseAutomationActionsAssociationID(id string) (string, string, error) {
+	parts := strings.Split(id, ":")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("invalid automation action association ID %q, expected <action_id>:<id>", id)
+	}
+
+	return parts[0], parts[1], nil
+}
@@ -0,0 +1,121 @@
+package pagerduty
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourcePagerDutyAutomationActionsActionServiceAssociation() *schema.Resource {
+	return &schema.Resource{
+		Create: resourcePagerDutyAutomationActionsActionServiceAssociationCreate,
+		Read:   resourcePagerDutyAutomationActionsActionServiceAssociationRead,
+		Delete: resourcePagerDutyAutomationActionsActionServiceAssociationDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourcePagerDutyAutomationActionsActionServiceAssociationImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"action_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"service_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func fetchPagerDutyAutomationActionsActionServiceAssociation(d *schema.ResourceData, meta interface{}, errCallback func(error, *schema.ResourceData) error) error {
+	client, _ := meta.(*Config).Client()
+
+	actionID, serviceID, err := parseAutomationActionsAssociationID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	return resource.Retry(2*time.Minute, func() *resource.RetryError {
+		if _, _, err := client.AutomationActions.GetServiceAssociation(actionID, serviceID); err != nil {
+			errResp := errCallback(err, d)
+			if errResp != nil {
+				time.Sleep(2 * time.Second)
+				return resource.RetryableError(errResp)
+			}
+
+			return nil
+		}
+
+		d.Set("action_id", actionID)
+		d.Set("service_id", serviceID)
+
+		return nil
+	})
+}
+
+func resourcePagerDutyAutomationActionsActionServiceAssociationCreate(d *schema.ResourceData, meta interface{}) error {
+	client, _ := meta.(*Config).Client()
+
+	actionID := d.Get("action_id").(string)
+	serviceID := d.Get("service_id").(string)
+
+	log.Printf("[INFO] Associating service: %s with PagerDuty automation action: %s", serviceID, actionID)
+
+	retryErr := resource.Retry(10*time.Second, func() *resource.RetryError {
+		if _, _, err := client.AutomationActions.AssociateService(actionID, serviceID); err != nil {
+			if isErrCode(err, 429) {
+				return resource.RetryableError(err)
+			}
+
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+
+	if retryErr != nil {
+		return retryErr
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s", actionID, serviceID))
+
+	return fetchPagerDutyAutomationActionsActionServiceAssociation(d, meta, genError)
+}
+
+func resourcePagerDutyAutomationActionsActionServiceAssociationRead(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[INFO] Reading PagerDuty automation action service association: %s", d.Id())
+	return fetchPagerDutyAutomationActionsActionServiceAssociation(d, meta, handleNotFoundError)
+}
+
+func resourcePagerDutyAutomationActionsActionServiceAssociationDelete(d *schema.ResourceData, meta interface{}) error {
+	client, _ := meta.(*Config).Client()
+
+	actionID, serviceID, err := parseAutomationActionsAssociationID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Dissociating service: %s from PagerDuty automation action: %s", serviceID, actionID)
+
+	if _, err := client.AutomationActions.DissociateService(actionID, serviceID); err != nil {
+		if !isErrCode(err, 404) {
+			return err
+		}
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func resourcePagerDutyAutomationActionsActionServiceAssociationImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	if _, _, err := parseAutomationActionsAssociationID(d.Id()); err != nil {
+		return nil, fmt.Errorf("Error importing pagerduty_automation_actions_action_service_association. Expecting an importation ID formed as '<action_id>:<service_id>'")
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,113 @@
+package pagerduty
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccPagerDutyAutomationActionsActionServiceAssociation_Basic(t *testing.T) {
+	action := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	service := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	username := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	email := fmt.Sprintf("%s@foo.com", username)
+	escalationPolicy := fmt.Sprintf("tf-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPagerDutyAutomationActionsActionServiceAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckPagerDutyAutomationActionsActionServiceAssociationConfig(action, service, username, email, escalationPolicy),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyAutomationActionsActionServiceAssociationExists("pagerduty_automation_actions_action_service_association.foo"),
+					resource.TestCheckResourceAttrPair(
+						"pagerduty_automation_actions_action_service_association.foo", "action_id", "pagerduty_automation_actions_action.foo", "id"),
+					resource.TestCheckResourceAttrPair(
+						"pagerduty_automation_actions_action_service_association.foo", "service_id", "pagerduty_service.foo", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckPagerDutyAutomationActionsActionServiceAssociationDestroy(s *terraform.State) error {
+	client, _ := testAccProvider.Meta().(*Config).Client()
+	for _, r := range s.RootModule().Resources {
+		if r.Type != "pagerduty_automation_actions_action_service_association" {
+			continue
+		}
+		actionID, serviceID, _ := parseAutomationActionsAssociationID(r.Primary.ID)
+		if _, _, err := client.AutomationActions.GetServiceAssociation(actionID, serviceID); err == nil {
+			return fmt.Errorf("Automation action service association still exists")
+		}
+	}
+	return nil
+}
+
+func testAccCheckPagerDutyAutomationActionsActionServiceAssociationExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No automation action service association ID is set")
+		}
+
+		actionID, serviceID, err := parseAutomationActionsAssociationID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		client, _ := testAccProvider.Meta().(*Config).Client()
+		if _, _, err := client.AutomationActions.GetServiceAssociation(actionID, serviceID); err != nil {
+			return err
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckPagerDutyAutomationActionsActionServiceAssociationConfig(action, service, username, email, escalationPolicy string) string {
+	return fmt.Sprintf(`
+resource "pagerduty_user" "foo" {
+  name  = "%s"
+  email = "%s"
+}
+
+resource "pagerduty_escalation_policy" "foo" {
+  name = "%s"
+  rule {
+    escalation_delay_in_minutes = 10
+    target {
+      type = "user_reference"
+      id   = pagerduty_user.foo.id
+    }
+  }
+}
+
+resource "pagerduty_service" "foo" {
+  name              = "%s"
+  escalation_policy = pagerduty_escalation_policy.foo.id
+}
+
+resource "pagerduty_automation_actions_action" "foo" {
+  name        = "%s"
+  action_type = "script"
+
+  action_data_reference {
+    script = "echo 'hello'"
+  }
+}
+
+resource "pagerduty_automation_actions_action_service_association" "foo" {
+  action_id  = pagerduty_automation_actions_action.foo.id
+  service_id = pagerduty_service.foo.id
+}
+`, username, email, escalationPolicy, service, action)
+}
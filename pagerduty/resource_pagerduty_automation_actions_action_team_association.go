@@ -0,0 +1,121 @@
+package pagerduty
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourcePagerDutyAutomationActionsActionTeamAssociation() *schema.Resource {
+	return &schema.Resource{
+		Create: resourcePagerDutyAutomationActionsActionTeamAssociationCreate,
+		Read:   resourcePagerDutyAutomationActionsActionTeamAssociationRead,
+		Delete: resourcePagerDutyAutomationActionsActionTeamAssociationDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourcePagerDutyAutomationActionsActionTeamAssociationImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"action_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"team_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func fetchPagerDutyAutomationActionsActionTeamAssociation(d *schema.ResourceData, meta interface{}, errCallback func(error, *schema.ResourceData) error) error {
+	client, _ := meta.(*Config).Client()
+
+	actionID, teamID, err := parseAutomationActionsAssociationID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	return resource.Retry(2*time.Minute, func() *resource.RetryError {
+		if _, _, err := client.AutomationActions.GetTeamAssociation(actionID, teamID); err != nil {
+			errResp := errCallback(err, d)
+			if errResp != nil {
+				time.Sleep(2 * time.Second)
+				return resource.RetryableError(errResp)
+			}
+
+			return nil
+		}
+
+		d.Set("action_id", actionID)
+		d.Set("team_id", teamID)
+
+		return nil
+	})
+}
+
+func resourcePagerDutyAutomationActionsActionTeamAssociationCreate(d *schema.ResourceData, meta interface{}) error {
+	client, _ := meta.(*Config).Client()
+
+	actionID := d.Get("action_id").(string)
+	teamID := d.Get("team_id").(string)
+
+	log.Printf("[INFO] Associating team: %s with PagerDuty automation action: %s", teamID, actionID)
+
+	retryErr := resource.Retry(10*time.Second, func() *resource.RetryError {
+		if _, _, err := client.AutomationActions.AssociateTeam(actionID, teamID); err != nil {
+			if isErrCode(err, 429) {
+				return resource.RetryableError(err)
+			}
+
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+
+	if retryErr != nil {
+		return retryErr
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s", actionID, teamID))
+
+	return fetchPagerDutyAutomationActionsActionTeamAssociation(d, meta, genError)
+}
+
+func resourcePagerDutyAutomationActionsActionTeamAssociationRead(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[INFO] Reading PagerDuty automation action team association: %s", d.Id())
+	return fetchPagerDutyAutomationActionsActionTeamAssociation(d, meta, handleNotFoundError)
+}
+
+func resourcePagerDutyAutomationActionsActionTeamAssociationDelete(d *schema.ResourceData, meta interface{}) error {
+	client, _ := meta.(*Config).Client()
+
+	actionID, teamID, err := parseAutomationActionsAssociationID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Dissociating team: %s from PagerDuty automation action: %s", teamID, actionID)
+
+	if _, err := client.AutomationActions.DissociateTeam(actionID, teamID); err != nil {
+		if !isErrCode(err, 404) {
+			return err
+		}
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func resourcePagerDutyAutomationActionsActionTeamAssociationImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	if _, _, err := parseAutomationActionsAssociationID(d.Id()); err != nil {
+		return nil, fmt.Errorf("Error importing pagerduty_automation_actions_action_team_association. Expecting an importation ID formed as '<action_id>:<team_id>'")
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
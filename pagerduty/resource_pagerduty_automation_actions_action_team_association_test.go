@@ -0,0 +1,93 @@
+package pagerduty
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccPagerDutyAutomationActionsActionTeamAssociation_Basic(t *testing.T) {
+	action := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	team := fmt.Sprintf("tf-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPagerDutyAutomationActionsActionTeamAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckPagerDutyAutomationActionsActionTeamAssociationConfig(action, team),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyAutomationActionsActionTeamAssociationExists("pagerduty_automation_actions_action_team_association.foo"),
+					resource.TestCheckResourceAttrPair(
+						"pagerduty_automation_actions_action_team_association.foo", "action_id", "pagerduty_automation_actions_action.foo", "id"),
+					resource.TestCheckResourceAttrPair(
+						"pagerduty_automation_actions_action_team_association.foo", "team_id", "pagerduty_team.foo", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckPagerDutyAutomationActionsActionTeamAssociationDestroy(s *terraform.State) error {
+	client, _ := testAccProvider.Meta().(*Config).Client()
+	for _, r := range s.RootModule().Resources {
+		if r.Type != "pagerduty_automation_actions_action_team_association" {
+			continue
+		}
+		actionID, teamID, _ := parseAutomationActionsAssociationID(r.Primary.ID)
+		if _, _, err := client.AutomationActions.GetTeamAssociation(actionID, teamID); err == nil {
+			return fmt.Errorf("Automation action team association still exists")
+		}
+	}
+	return nil
+}
+
+func testAccCheckPagerDutyAutomationActionsActionTeamAssociationExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No automation action team association ID is set")
+		}
+
+		actionID, teamID, err := parseAutomationActionsAssociationID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		client, _ := testAccProvider.Meta().(*Config).Client()
+		if _, _, err := client.AutomationActions.GetTeamAssociation(actionID, teamID); err != nil {
+			return err
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckPagerDutyAutomationActionsActionTeamAssociationConfig(action, team string) string {
+	return fmt.Sprintf(`
+resource "pagerduty_team" "foo" {
+  name = "%s"
+}
+
+resource "pagerduty_automation_actions_action" "foo" {
+  name        = "%s"
+  action_type = "script"
+
+  action_data_reference {
+    script = "echo 'hello'"
+  }
+}
+
+resource "pagerduty_automation_actions_action_team_association" "foo" {
+  action_id = pagerduty_automation_actions_action.foo.id
+  team_id   = pagerduty_team.foo.id
+}
+`, team, action)
+}
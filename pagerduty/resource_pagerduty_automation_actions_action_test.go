@@ -0,0 +1,222 @@
+package pagerduty
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func init() {
+	resource.AddTestSweepers("pagerduty_automation_actions_action", &resource.Sweeper{
+		Name: "pagerduty_automation_actions_action",
+		F:    testSweepAutomationActionsAction,
+	})
+}
+
+func testSweepAutomationActionsAction(region string) error {
+	config, err := sharedConfigForRegion(region)
+	if err != nil {
+		return err
+	}
+
+	client, err := config.Client()
+	if err != nil {
+		return err
+	}
+
+	resp, _, err := client.AutomationActions.ListActions()
+	if err != nil {
+		return err
+	}
+
+	for _, action := range resp.Actions {
+		if strings.HasPrefix(action.Name, "test") || strings.HasPrefix(action.Name, "tf-") {
+			log.Printf("Destroying automation action %s (%s)", action.Name, action.ID)
+			if _, err := client.AutomationActions.DeleteAction(action.ID); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func TestValidateAutomationActionsAction(t *testing.T) {
+	cases := []struct {
+		actionType string
+		runnerSet  bool
+		data       map[string]interface{}
+		wantErr    bool
+	}{
+		{"script", false, map[string]interface{}{"script": "echo 1"}, false},
+		{"script", false, map[string]interface{}{"script": "echo 1", "invocation_command": "/bin/bash"}, false},
+		{"script", false, map[string]interface{}{}, true},
+		{"script", false, map[string]interface{}{"script": "echo 1", "process_automation_job_id": "P123456"}, true},
+		{"process_automation", true, map[string]interface{}{"process_automation_job_id": "P123456"}, false},
+		{"process_automation", false, map[string]interface{}{"process_automation_job_id": "P123456"}, true},
+		{"process_automation", true, map[string]interface{}{"process_automation_node_filter": "tags: production"}, true},
+		{"process_automation", true, map[string]interface{}{"process_automation_job_id": "P123456", "script": "echo 1"}, true},
+	}
+
+	for _, c := range cases {
+		err := validateAutomationActionsAction(c.actionType, c.runnerSet, c.data)
+		if (err != nil) != c.wantErr {
+			t.Errorf("%+v: got error %v", c, err)
+		}
+	}
+}
+
+func TestPagerDutyAutomationActionsActionCustomizeDiff_RunnerType(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/automation_actions/runners/PSIDECAR":
+			w.Write([]byte(`{"runner":{"id":"PSIDECAR","runner_type":"sidecar"}}`))
+		case "/automation_actions/runners/PRUNBOOK":
+			w.Write([]byte(`{"runner":{"id":"PRUNBOOK","runner_type":"runbook"}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"error":{"code":2100,"message":"Not Found"}}`))
+		}
+	}))
+	defer srv.Close()
+
+	config := &Config{Token: "foo", ApiUrlOverride: srv.URL, SkipCredsValidation: true}
+
+	cases := []struct {
+		actionType string
+		runnerID   string
+		wantErr    string
+	}{
+		{"script", "PSIDECAR", ""},
+		{"script", "PRUNBOOK", "a script action must run on a sidecar runner, but runner_id PRUNBOOK is a runbook runner"},
+		{"process_automation", "PRUNBOOK", ""},
+		{"process_automation", "PSIDECAR", "a process_automation action must run on a runbook runner, but runner_id PSIDECAR is a sidecar runner"},
+		{"process_automation", "PMISSING", "runner_id PMISSING does not exist"},
+	}
+
+	for _, c := range cases {
+		data := map[string]interface{}{"script": "echo 1"}
+		if c.actionType == "process_automation" {
+			data = map[string]interface{}{"process_automation_job_id": "P123456"}
+		}
+
+		_, err := resourcePagerDutyAutomationActionsAction().Diff(context.Background(), nil, terraform.NewResourceConfigRaw(map[string]interface{}{
+			"name":                  "tf-action",
+			"action_type":           c.actionType,
+			"runner_id":             c.runnerID,
+			"action_data_reference": []interface{}{data},
+		}), config)
+
+		if c.wantErr == "" && err != nil {
+			t.Errorf("%s on %s: unexpected error %v", c.actionType, c.runnerID, err)
+		}
+		if c.wantErr != "" && (err == nil || !strings.Contains(err.Error(), c.wantErr)) {
+			t.Errorf("%s on %s: expected error %q, got %v", c.actionType, c.runnerID, c.wantErr, err)
+		}
+	}
+}
+
+func TestAccPagerDutyAutomationActionsAction_Basic(t *testing.T) {
+	name := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	nameUpdated := fmt.Sprintf("tf-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPagerDutyAutomationActionsActionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckPagerDutyAutomationActionsActionConfig(name, "echo 'hello'"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyAutomationActionsActionExists("pagerduty_automation_actions_action.foo"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_automation_actions_action.foo", "name", name),
+					resource.TestCheckResourceAttr(
+						"pagerduty_automation_actions_action.foo", "action_type", "script"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_automation_actions_action.foo", "action_classification", "diagnostic"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_automation_actions_action.foo", "action_data_reference.0.script", "echo 'hello'"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_automation_actions_action.foo", "action_data_reference.0.invocation_command", "/bin/bash"),
+				),
+			},
+			{
+				Config: testAccCheckPagerDutyAutomationActionsActionConfig(nameUpdated, "echo 'bye'"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyAutomationActionsActionExists("pagerduty_automation_actions_action.foo"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_automation_actions_action.foo", "name", nameUpdated),
+					resource.TestCheckResourceAttr(
+						"pagerduty_automation_actions_action.foo", "action_data_reference.0.script", "echo 'bye'"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckPagerDutyAutomationActionsActionDestroy(s *terraform.State) error {
+	client, _ := testAccProvider.Meta().(*Config).Client()
+	for _, r := range s.RootModule().Resources {
+		if r.Type != "pagerduty_automation_actions_action" {
+			continue
+		}
+		if _, _, err := client.AutomationActions.GetAction(r.Primary.ID); err == nil {
+			return fmt.Errorf("Automation action still exists")
+		}
+	}
+	return nil
+}
+
+func testAccCheckPagerDutyAutomationActionsActionExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No automation action ID is set")
+		}
+
+		client, _ := testAccProvider.Meta().(*Config).Client()
+		found, _, err := client.AutomationActions.GetAction(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if found.ID != rs.Primary.ID {
+			return fmt.Errorf("Automation action not found: %v - %v", rs.Primary.ID, found)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckPagerDutyAutomationActionsActionConfig(name, script string) string {
+	return fmt.Sprintf(`
+resource "pagerduty_automation_actions_runner" "foo" {
+  name        = "%[1]s"
+  runner_type = "sidecar"
+}
+
+resource "pagerduty_automation_actions_action" "foo" {
+  name                  = "%[1]s"
+  description           = "foo"
+  action_type           = "script"
+  runner_id             = pagerduty_automation_actions_runner.foo.id
+  action_classification = "diagnostic"
+
+  action_data_reference {
+    script             = "%[2]s"
+    invocation_command = "/bin/bash"
+  }
+}
+`, name, script)
+}
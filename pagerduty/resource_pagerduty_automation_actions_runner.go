@@ -0,0 +1,211 @@
+package pagerduty
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/heimweh/go-pagerduty/pagerduty"
+)
+
+func resourcePagerDutyAutomationActionsRunner() *schema.Resource {
+	return &schema.Resource{
+		Create: resourcePagerDutyAutomationActionsRunnerCreate,
+		Read:   resourcePagerDutyAutomationActionsRunnerRead,
+		Update: resourcePagerDutyAutomationActionsRunnerUpdate,
+		Delete: resourcePagerDutyAutomationActionsRunnerDelete,
+		CustomizeDiff: func(context context.Context, diff *schema.ResourceDiff, i interface{}) error {
+			// Runbook settings that are only known after apply are not validated.
+			if !diff.NewValueKnown("runbook_base_uri") || !diff.NewValueKnown("runbook_api_key") {
+				return nil
+			}
+
+			return validateAutomationActionsRunner(
+				diff.Get("runner_type").(string),
+				diff.Get("runbook_base_uri").(string),
+				diff.Get("runbook_api_key").(string),
+				diff.Id() == "",
+			)
+		},
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"runner_type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validateValueFunc([]string{
+					"sidecar",
+					"runbook",
+				}),
+			},
+			"runbook_base_uri": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"runbook_api_key": {
+				Type:      schema.TypeString,
+				Optional:  true,
+				Sensitive: true,
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"creation_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"last_seen": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// validateAutomationActionsRunner checks the runbook settings of a runner.
+// Runbook runners need the URI of the Runbook Automation instance and, when
+// they are created, an API key for it. Sidecar runners take neither.
+func validateAutomationActionsRunner(runnerType, runbookBaseURI, runbookAPIKey string, creating bool) error {
+	switch runnerType {
+	case "runbook":
+		if runbookBaseURI == "" {
+			return fmt.Errorf("runbook_base_uri must be set for a runbook runner")
+		}
+		if creating && runbookAPIKey == "" {
+			return fmt.Errorf("runbook_api_key must be set for a runbook runner")
+		}
+	case "sidecar":
+		if runbookBaseURI != "" || runbookAPIKey != "" {
+			return fmt.Errorf("runbook_base_uri and runbook_api_key cannot be set for a sidecar runner")
+		}
+	}
+
+	return nil
+}
+
+func buildAutomationActionsRunnerStruct(d *schema.ResourceData) *pagerduty.AutomationActionsRunner {
+	description := d.Get("description").(string)
+
+	runner := &pagerduty.AutomationActionsRunner{
+		Name:        d.Get("name").(string),
+		Description: &description,
+		RunnerType:  d.Get("runner_type").(string),
+	}
+
+	if runner.RunnerType == "runbook" {
+		runner.RunbookBaseUri = d.Get("runbook_base_uri").(string)
+
+		// The API key is write-only, so it is only sent when it changes.
+		if d.IsNewResource() || d.HasChange("runbook_api_key") {
+			runner.RunbookApiKey = d.Get("runbook_api_key").(string)
+		}
+	}
+
+	return runner
+}
+
+func fetchPagerDutyAutomationActionsRunner(d *schema.ResourceData, meta interface{}, errCallback func(error, *schema.ResourceData) error) error {
+	client, _ := meta.(*Config).Client()
+
+	return resource.Retry(2*time.Minute, func() *resource.RetryError {
+		runner, _, err := client.AutomationActions.GetRunner(d.Id())
+		if err != nil {
+			errResp := errCallback(err, d)
+			if errResp != nil {
+				time.Sleep(2 * time.Second)
+				return resource.RetryableError(errResp)
+			}
+
+			return nil
+		}
+
+		d.Set("name", runner.Name)
+		d.Set("runner_type", runner.RunnerType)
+		d.Set("runbook_base_uri", runner.RunbookBaseUri)
+		d.Set("type", runner.Type)
+		d.Set("creation_time", runner.CreationTime)
+		d.Set("last_seen", runner.LastSeenTime)
+
+		if runner.Description != nil {
+			d.Set("description", *runner.Description)
+		} else {
+			d.Set("description", "")
+		}
+
+		return nil
+	})
+}
+
+func resourcePagerDutyAutomationActionsRunnerCreate(d *schema.ResourceData, meta interface{}) error {
+	client, _ := meta.(*Config).Client()
+
+	runner := buildAutomationActionsRunnerStruct(d)
+
+	log.Printf("[INFO] Creating PagerDuty automation actions runner: %s", runner.Name)
+
+	retryErr := resource.Retry(10*time.Second, func() *resource.RetryError {
+		if runner, _, err := client.AutomationActions.CreateRunner(runner); err != nil {
+			if isErrCode(err, 429) {
+				return resource.RetryableError(err)
+			}
+
+			return resource.NonRetryableError(err)
+		} else if runner != nil {
+			d.SetId(runner.ID)
+		}
+		return nil
+	})
+
+	if retryErr != nil {
+		return retryErr
+	}
+
+	return fetchPagerDutyAutomationActionsRunner(d, meta, genError)
+}
+
+func resourcePagerDutyAutomationActionsRunnerRead(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[INFO] Reading PagerDuty automation actions runner: %s", d.Id())
+	return fetchPagerDutyAutomationActionsRunner(d, meta, handleNotFoundError)
+}
+
+func resourcePagerDutyAutomationActionsRunnerUpdate(d *schema.ResourceData, meta interface{}) error {
+	client, _ := meta.(*Config).Client()
+
+	runner := buildAutomationActionsRunnerStruct(d)
+
+	log.Printf("[INFO] Updating PagerDuty automation actions runner: %s", d.Id())
+
+	if _, _, err := client.AutomationActions.UpdateRunner(d.Id(), runner); err != nil {
+		return err
+	}
+
+	return fetchPagerDutyAutomationActionsRunner(d, meta, genError)
+}
+
+func resourcePagerDutyAutomationActionsRunnerDelete(d *schema.ResourceData, meta interface{}) error {
+	client, _ := meta.(*Config).Client()
+
+	log.Printf("[INFO] Deleting PagerDuty automation actions runner: %s", d.Id())
+
+	if _, err := client.AutomationActions.DeleteRunner(d.Id()); err != nil {
+		return err
+	}
+
+	d.SetId("")
+
+	return nil
+}
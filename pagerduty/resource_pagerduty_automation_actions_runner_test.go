@@ -0,0 +1,161 @@
+package pagerduty
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func init() {
+	resource.AddTestSweepers("pagerduty_automation_actions_runner", &resource.Sweeper{
+		Name: "pagerduty_automation_actions_runner",
+		F:    testSweepAutomationActionsRunner,
+		Dependencies: []string{
+			"pagerduty_automation_actions_action",
+		},
+	})
+}
+
+func testSweepAutomationActionsRunner(region string) error {
+	config, err := sharedConfigForRegion(region)
+	if err != nil {
+		return err
+	}
+
+	client, err := config.Client()
+	if err != nil {
+		return err
+	}
+
+	resp, _, err := client.AutomationActions.ListRunners()
+	if err != nil {
+		return err
+	}
+
+	for _, runner := range resp.Runners {
+		if strings.HasPrefix(runner.Name, "test") || strings.HasPrefix(runner.Name, "tf-") {
+			log.Printf("Destroying automation actions runner %s (%s)", runner.Name, runner.ID)
+			if _, err := client.AutomationActions.DeleteRunner(runner.ID); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func TestValidateAutomationActionsRunner(t *testing.T) {
+	cases := []struct {
+		runnerType string
+		baseURI    string
+		apiKey     string
+		creating   bool
+		wantErr    bool
+	}{
+		{"sidecar", "", "", true, false},
+		{"sidecar", "https://runbook.example.com", "", true, true},
+		{"runbook", "https://runbook.example.com", "secret", true, false},
+		{"runbook", "https://runbook.example.com", "", true, true},
+		{"runbook", "https://runbook.example.com", "", false, false},
+		{"runbook", "", "secret", true, true},
+	}
+
+	for _, c := range cases {
+		err := validateAutomationActionsRunner(c.runnerType, c.baseURI, c.apiKey, c.creating)
+		if (err != nil) != c.wantErr {
+			t.Errorf("%+v: got error %v", c, err)
+		}
+	}
+}
+
+func TestAccPagerDutyAutomationActionsRunner_Basic(t *testing.T) {
+	name := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	nameUpdated := fmt.Sprintf("tf-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPagerDutyAutomationActionsRunnerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckPagerDutyAutomationActionsRunnerConfig(name, "foo"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyAutomationActionsRunnerExists("pagerduty_automation_actions_runner.foo"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_automation_actions_runner.foo", "name", name),
+					resource.TestCheckResourceAttr(
+						"pagerduty_automation_actions_runner.foo", "description", "foo"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_automation_actions_runner.foo", "runner_type", "runbook"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_automation_actions_runner.foo", "runbook_base_uri", "cat-cat"),
+					resource.TestCheckResourceAttrSet(
+						"pagerduty_automation_actions_runner.foo", "creation_time"),
+				),
+			},
+			{
+				Config: testAccCheckPagerDutyAutomationActionsRunnerConfig(nameUpdated, "bar"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyAutomationActionsRunnerExists("pagerduty_automation_actions_runner.foo"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_automation_actions_runner.foo", "name", nameUpdated),
+					resource.TestCheckResourceAttr(
+						"pagerduty_automation_actions_runner.foo", "description", "bar"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckPagerDutyAutomationActionsRunnerDestroy(s *terraform.State) error {
+	client, _ := testAccProvider.Meta().(*Config).Client()
+	for _, r := range s.RootModule().Resources {
+		if r.Type != "pagerduty_automation_actions_runner" {
+			continue
+		}
+		if _, _, err := client.AutomationActions.GetRunner(r.Primary.ID); err == nil {
+			return fmt.Errorf("Automation actions runner still exists")
+		}
+	}
+	return nil
+}
+
+func testAccCheckPagerDutyAutomationActionsRunnerExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No automation actions runner ID is set")
+		}
+
+		client, _ := testAccProvider.Meta().(*Config).Client()
+		found, _, err := client.AutomationActions.GetRunner(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if found.ID != rs.Primary.ID {
+			return fmt.Errorf("Automation actions runner not found: %v - %v", rs.Primary.ID, found)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckPagerDutyAutomationActionsRunnerConfig(name, description string) string {
+	return fmt.Sprintf(`
+resource "pagerduty_automation_actions_runner" "foo" {
+  name             = "%s"
+  description      = "%s"
+  runner_type      = "runbook"
+  runbook_base_uri = "cat-cat"
+  runbook_api_key  = "cat-cat-api-key"
+}
+`, name, description)
+}
@@ -57,6 +57,7 @@ func resourcePagerDutyBusinessService() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"deletion_protection": deletionProtectionSchema(),
 		},
 	}
 }
@@ -122,6 +123,8 @@ func resourcePagerDutyBusinessServiceRead(d *schema.ResourceData, meta interface
 
 	log.Printf("[INFO] Reading PagerDuty business service %s", d.Id())
 
+	setDefaultDeletionProtection(d)
+
 	retryErr := resource.Retry(2*time.Minute, func() *resource.RetryError {
 		if businessService, _, err := client.BusinessServices.Get(d.Id()); err != nil {
 			return resource.RetryableError(err)
@@ -168,6 +171,10 @@ func resourcePagerDutyBusinessServiceUpdate(d *schema.ResourceData, meta interfa
 }
 
 func resourcePagerDutyBusinessServiceDelete(d *schema.ResourceData, meta interface{}) error {
+	if err := checkDeletionProtection(d, "pagerduty_business_service"); err != nil {
+		return err
+	}
+
 	client, _ := meta.(*Config).Client()
 
 	log.Printf("[INFO] Deleting PagerDuty business service %s", d.Id())
@@ -0,0 +1,135 @@
+package pagerduty
+
+import (
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/heimweh/go-pagerduty/pagerduty"
+)
+
+// The priority threshold is a setting of the account, there is a single one.
+const businessServicePriorityThresholdID = "global"
+
+func resourcePagerDutyBusinessServicePriorityThreshold() *schema.Resource {
+	return &schema.Resource{
+		Create: resourcePagerDutyBusinessServicePriorityThresholdCreate,
+		Read:   resourcePagerDutyBusinessServicePriorityThresholdRead,
+		Update: resourcePagerDutyBusinessServicePriorityThresholdUpdate,
+		Delete: resourcePagerDutyBusinessServicePriorityThresholdDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourcePagerDutyBusinessServicePriorityThresholdImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"priority": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The ID of the lowest priority of the incidents impacting business services",
+			},
+			"order": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func buildBusinessServicePriorityThresholdsStruct(d *schema.ResourceData) *pagerduty.BusinessServicePriorityThresholds {
+	return &pagerduty.BusinessServicePriorityThresholds{
+		GlobalThreshold: &pagerduty.BusinessServicePriority{
+			ID: d.Get("priority").(string),
+		},
+	}
+}
+
+func resourcePagerDutyBusinessServicePriorityThresholdCreate(d *schema.ResourceData, meta interface{}) error {
+	client, _ := meta.(*Config).Client()
+
+	thresholds := buildBusinessServicePriorityThresholdsStruct(d)
+
+	log.Printf("[INFO] Setting PagerDuty business service priority threshold to %s", thresholds.GlobalThreshold.ID)
+
+	retryErr := resource.Retry(2*time.Minute, func() *resource.RetryError {
+		if _, _, err := client.BusinessServices.UpdatePriorityThresholds(thresholds); err != nil {
+			if isErrCode(err, 400) {
+				return resource.NonRetryableError(err)
+			}
+			return resource.RetryableError(err)
+		}
+		return nil
+	})
+	if retryErr != nil {
+		time.Sleep(2 * time.Second)
+		return retryErr
+	}
+
+	d.SetId(businessServicePriorityThresholdID)
+
+	return resourcePagerDutyBusinessServicePriorityThresholdRead(d, meta)
+}
+
+func resourcePagerDutyBusinessServicePriorityThresholdRead(d *schema.ResourceData, meta interface{}) error {
+	client, _ := meta.(*Config).Client()
+
+	log.Printf("[INFO] Reading PagerDuty business service priority threshold")
+
+	return resource.Retry(2*time.Minute, func() *resource.RetryError {
+		thresholds, _, err := client.BusinessServices.GetPriorityThresholds()
+		if err != nil {
+			errResp := handleNotFoundError(err, d)
+			if errResp != nil {
+				time.Sleep(2 * time.Second)
+				return resource.RetryableError(errResp)
+			}
+
+			return nil
+		}
+
+		if thresholds.GlobalThreshold == nil || thresholds.GlobalThreshold.ID == "" {
+			log.Printf("[WARN] Removing %s because the business service priority threshold isn't set", d.Id())
+			d.SetId("")
+			return nil
+		}
+
+		d.Set("priority", thresholds.GlobalThreshold.ID)
+		d.Set("order", thresholds.GlobalThreshold.Order)
+
+		return nil
+	})
+}
+
+func resourcePagerDutyBusinessServicePriorityThresholdUpdate(d *schema.ResourceData, meta interface{}) error {
+	client, _ := meta.(*Config).Client()
+
+	thresholds := buildBusinessServicePriorityThresholdsStruct(d)
+
+	log.Printf("[INFO] Updating PagerDuty business service priority threshold to %s", thresholds.GlobalThreshold.ID)
+
+	if _, _, err := client.BusinessServices.UpdatePriorityThresholds(thresholds); err != nil {
+		return err
+	}
+
+	return resourcePagerDutyBusinessServicePriorityThresholdRead(d, meta)
+}
+
+func resourcePagerDutyBusinessServicePriorityThresholdDelete(d *schema.ResourceData, meta interface{}) error {
+	client, _ := meta.(*Config).Client()
+
+	log.Printf("[INFO] Clearing PagerDuty business service priority threshold")
+
+	if _, err := client.BusinessServices.DeletePriorityThresholds(); err != nil {
+		return err
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func resourcePagerDutyBusinessServicePriorityThresholdImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	// Whatever the importation ID, there is a single threshold.
+	d.SetId(businessServicePriorityThresholdID)
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,127 @@
+package pagerduty
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/heimweh/go-pagerduty/pagerduty"
+)
+
+func TestPagerDutyBusinessServicePriorityThreshold_CRUD(t *testing.T) {
+	var threshold *pagerduty.BusinessServicePriority
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/business_services/priority_thresholds" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		switch r.Method {
+		case "PUT":
+			var thresholds pagerduty.BusinessServicePriorityThresholds
+			if err := json.NewDecoder(r.Body).Decode(&thresholds); err != nil {
+				t.Error(err)
+			}
+			threshold = &pagerduty.BusinessServicePriority{ID: thresholds.GlobalThreshold.ID, Order: 256}
+		case "DELETE":
+			threshold = nil
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		json.NewEncoder(w).Encode(&pagerduty.BusinessServicePriorityThresholds{GlobalThreshold: threshold})
+	}))
+	defer srv.Close()
+
+	d := schema.TestResourceDataRaw(t, resourcePagerDutyBusinessServicePriorityThreshold().Schema, map[string]interface{}{
+		"priority": "PP1ABCD",
+	})
+
+	config := &Config{Token: "foo", ApiUrlOverride: srv.URL, SkipCredsValidation: true}
+	if err := resourcePagerDutyBusinessServicePriorityThreshold().Create(d, config); err != nil {
+		t.Fatal(err)
+	}
+	if d.Id() != businessServicePriorityThresholdID || d.Get("order").(int) != 256 {
+		t.Errorf("unexpected state %q with order %d", d.Id(), d.Get("order"))
+	}
+
+	if err := resourcePagerDutyBusinessServicePriorityThreshold().Delete(d, config); err != nil {
+		t.Fatal(err)
+	}
+	if threshold != nil {
+		t.Errorf("expected the threshold to be cleared, got %v", threshold)
+	}
+
+	d.SetId(businessServicePriorityThresholdID)
+	if err := resourcePagerDutyBusinessServicePriorityThreshold().Read(d, config); err != nil {
+		t.Fatal(err)
+	}
+	if d.Id() != "" {
+		t.Error("expected a cleared threshold to be removed from the state")
+	}
+}
+
+func TestAccPagerDutyBusinessServicePriorityThreshold_Basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPagerDutyBusinessServicePriorityThresholdDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckPagerDutyBusinessServicePriorityThresholdConfig("P1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"pagerduty_business_service_priority_threshold.foo", "priority", "data.pagerduty_priority.foo", "id"),
+				),
+			},
+			{
+				Config: testAccCheckPagerDutyBusinessServicePriorityThresholdConfig("P2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"pagerduty_business_service_priority_threshold.foo", "priority", "data.pagerduty_priority.foo", "id"),
+				),
+			},
+			{
+				ResourceName:      "pagerduty_business_service_priority_threshold.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckPagerDutyBusinessServicePriorityThresholdDestroy(s *terraform.State) error {
+	client, _ := testAccProvider.Meta().(*Config).Client()
+	for _, r := range s.RootModule().Resources {
+		if r.Type != "pagerduty_business_service_priority_threshold" {
+			continue
+		}
+
+		thresholds, _, err := client.BusinessServices.GetPriorityThresholds()
+		if err != nil {
+			return err
+		}
+		if thresholds.GlobalThreshold != nil && thresholds.GlobalThreshold.ID != "" {
+			return fmt.Errorf("Business service priority threshold still set to %s", thresholds.GlobalThreshold.ID)
+		}
+	}
+	return nil
+}
+
+func testAccCheckPagerDutyBusinessServicePriorityThresholdConfig(priority string) string {
+	return fmt.Sprintf(`
+data "pagerduty_priority" "foo" {
+  name = "%s"
+}
+
+resource "pagerduty_business_service_priority_threshold" "foo" {
+  priority = data.pagerduty_priority.foo.id
+}
+`, priority)
+}
@@ -0,0 +1,138 @@
+package pagerduty
+
+import (
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/heimweh/go-pagerduty/pagerduty"
+)
+
+// resourcePagerDutyChangeEvent sends a change event when it is created. Sent
+// events can't be changed, so changing any argument replaces the resource and
+// sends a new change event, like the triggers of a null_resource. Nothing is
+// stored in PagerDuty that could be read back or deleted.
+func resourcePagerDutyChangeEvent() *schema.Resource {
+	return &schema.Resource{
+		Create: resourcePagerDutyChangeEventCreate,
+		Read:   resourcePagerDutyChangeEventRead,
+		Delete: resourcePagerDutyChangeEventDelete,
+		Schema: map[string]*schema.Schema{
+			"routing_key": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Sensitive:    true,
+				ValidateFunc: validation.StringLenBetween(32, 32),
+			},
+			"summary": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1024),
+			},
+			"source": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"custom_details": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"link": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"href": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"text": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			"triggers": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"timestamp": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func buildChangeEventStruct(d *schema.ResourceData, timestamp string) *pagerduty.ChangeEvent {
+	event := &pagerduty.ChangeEvent{
+		RoutingKey: d.Get("routing_key").(string),
+		Payload: &pagerduty.ChangeEventPayload{
+			Summary:   d.Get("summary").(string),
+			Source:    d.Get("source").(string),
+			Timestamp: timestamp,
+		},
+	}
+
+	if details := d.Get("custom_details").(map[string]interface{}); len(details) > 0 {
+		event.Payload.CustomDetails = details
+	}
+
+	for _, l := range d.Get("link").([]interface{}) {
+		link := l.(map[string]interface{})
+		event.Links = append(event.Links, &pagerduty.EventLink{
+			Href: link["href"].(string),
+			Text: link["text"].(string),
+		})
+	}
+
+	return event
+}
+
+func resourcePagerDutyChangeEventCreate(d *schema.ResourceData, meta interface{}) error {
+	client, err := meta.(*Config).EventsClient()
+	if err != nil {
+		return err
+	}
+
+	timestamp := time.Now().UTC().Format(time.RFC3339)
+	event := buildChangeEventStruct(d, timestamp)
+
+	log.Printf("[INFO] Sending PagerDuty change event: %s", event.Payload.Summary)
+
+	if _, err := client.SendChangeEvent(event); err != nil {
+		return err
+	}
+
+	d.SetId(resource.UniqueId())
+	d.Set("timestamp", timestamp)
+
+	return nil
+}
+
+func resourcePagerDutyChangeEventRead(d *schema.ResourceData, meta interface{}) error {
+	return nil
+}
+
+func resourcePagerDutyChangeEventDelete(d *schema.ResourceData, meta interface{}) error {
+	d.SetId("")
+
+	return nil
+}
@@ -0,0 +1,235 @@
+package pagerduty
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/heimweh/go-pagerduty/pagerduty"
+)
+
+const testChangeEventRoutingKey = "R0UT1NGK3Y0000000000000000000000"
+
+func TestPagerDutyChangeEvent_Create(t *testing.T) {
+	var requests int
+	var received pagerduty.ChangeEvent
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if r.Method != "POST" || r.URL.Path != "/v2/change/enqueue" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+
+		// The first attempt is rate limited and has to be retried.
+		if requests == 1 {
+			w.WriteHeader(http.StatusTooManyRequests)
+			fmt.Fprint(w, `{"status":"throttle event","message":"Requests for this service are arriving too quickly."}`)
+			return
+		}
+
+		if err := json.NewDecoder(r.Body).Decode(&received); err != nil {
+			t.Fatal(err)
+		}
+		w.WriteHeader(http.StatusAccepted)
+		fmt.Fprint(w, `{"status":"success","message":"Change event processed"}`)
+	}))
+	defer srv.Close()
+
+	d := schema.TestResourceDataRaw(t, resourcePagerDutyChangeEvent().Schema, map[string]interface{}{
+		"routing_key":    testChangeEventRoutingKey,
+		"summary":        "Applied infra stack",
+		"source":         "terraform",
+		"custom_details": map[string]interface{}{"workspace": "production"},
+		"link": []interface{}{
+			map[string]interface{}{"href": "https://ci.example.com/runs/42", "text": "CI run"},
+		},
+		"triggers": map[string]interface{}{"version": "42"},
+	})
+
+	if err := resourcePagerDutyChangeEventCreate(d, &Config{EventsUrlOverride: srv.URL}); err != nil {
+		t.Fatal(err)
+	}
+
+	if requests != 2 {
+		t.Errorf("expected the throttled change event to be sent twice, got %d requests", requests)
+	}
+	if d.Id() == "" || d.Get("timestamp").(string) == "" {
+		t.Errorf("expected an ID and a timestamp to be set, got %q and %q", d.Id(), d.Get("timestamp"))
+	}
+
+	if received.RoutingKey != testChangeEventRoutingKey {
+		t.Errorf("unexpected routing key %q", received.RoutingKey)
+	}
+	if received.Payload == nil || received.Payload.Summary != "Applied infra stack" || received.Payload.Source != "terraform" {
+		t.Fatalf("unexpected payload %+v", received.Payload)
+	}
+	if received.Payload.Timestamp != d.Get("timestamp").(string) {
+		t.Errorf("expected timestamp %q, got %q", d.Get("timestamp"), received.Payload.Timestamp)
+	}
+	if received.Payload.CustomDetails["workspace"] != "production" {
+		t.Errorf("unexpected custom details %v", received.Payload.CustomDetails)
+	}
+	if len(received.Links) != 1 || received.Links[0].Href != "https://ci.example.com/runs/42" || received.Links[0].Text != "CI run" {
+		t.Errorf("unexpected links %v", received.Links)
+	}
+}
+
+func TestPagerDutyChangeEvent_CreateRejected(t *testing.T) {
+	var requests int
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, `{"status":"invalid event","message":"Event object is invalid","errors":["'routing_key' is invalid"]}`)
+	}))
+	defer srv.Close()
+
+	d := schema.TestResourceDataRaw(t, resourcePagerDutyChangeEvent().Schema, map[string]interface{}{
+		"routing_key": testChangeEventRoutingKey,
+		"summary":     "Applied infra stack",
+	})
+
+	err := resourcePagerDutyChangeEventCreate(d, &Config{EventsUrlOverride: srv.URL})
+	if e, ok := err.(*pagerduty.EventsV2Error); !ok || e.StatusCode != http.StatusBadRequest || len(e.Errors) != 1 {
+		t.Fatalf("expected the events API error, got %v", err)
+	}
+	if requests != 1 {
+		t.Errorf("expected a rejected change event not to be retried, got %d requests", requests)
+	}
+	if d.Id() != "" {
+		t.Errorf("expected no ID to be set, got %q", d.Id())
+	}
+}
+
+func TestPagerDutyChangeEvent_ChangeSendsNewEvent(t *testing.T) {
+	state := &terraform.InstanceState{
+		ID: "1",
+		Attributes: map[string]string{
+			"id":                       "1",
+			"routing_key":              testChangeEventRoutingKey,
+			"summary":                  "Applied infra stack",
+			"source":                   "terraform",
+			"custom_details.%":         "1",
+			"custom_details.workspace": "production",
+			"link.#":                   "1",
+			"link.0.href":              "https://ci.example.com/runs/42",
+			"link.0.text":              "CI run",
+			"timestamp":                "2026-10-18T12:00:00Z",
+		},
+	}
+	config := map[string]interface{}{
+		"routing_key":    testChangeEventRoutingKey,
+		"summary":        "Applied infra stack",
+		"source":         "terraform",
+		"custom_details": map[string]interface{}{"workspace": "production"},
+		"link": []interface{}{
+			map[string]interface{}{"href": "https://ci.example.com/runs/42", "text": "CI run"},
+		},
+	}
+
+	for key, value := range map[string]interface{}{
+		"summary":        "Rolled back infra stack",
+		"source":         "ci",
+		"custom_details": map[string]interface{}{"workspace": "staging"},
+		"link": []interface{}{
+			map[string]interface{}{"href": "https://ci.example.com/runs/43", "text": "CI run"},
+		},
+	} {
+		raw := make(map[string]interface{}, len(config))
+		for k, v := range config {
+			raw[k] = v
+		}
+		raw[key] = value
+
+		diff, err := resourcePagerDutyChangeEvent().Diff(context.Background(), state, terraform.NewResourceConfigRaw(raw), &Config{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff == nil || !diff.RequiresNew() {
+			t.Errorf("expected a change of %s to send a new change event, got %v", key, diff)
+		}
+	}
+}
+
+func TestAccPagerDutyChangeEvent_Basic(t *testing.T) {
+	username := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	email := fmt.Sprintf("%s@foo.com", username)
+	escalationPolicy := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	service := fmt.Sprintf("tf-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckPagerDutyChangeEventConfig(username, email, escalationPolicy, service, "1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("pagerduty_change_event.foo", "timestamp"),
+					resource.TestCheckResourceAttr("pagerduty_change_event.foo", "triggers.version", "1"),
+				),
+			},
+			{
+				Config: testAccCheckPagerDutyChangeEventConfig(username, email, escalationPolicy, service, "2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("pagerduty_change_event.foo", "timestamp"),
+					resource.TestCheckResourceAttr("pagerduty_change_event.foo", "triggers.version", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckPagerDutyChangeEventConfig(username, email, escalationPolicy, service, version string) string {
+	return fmt.Sprintf(`
+resource "pagerduty_user" "foo" {
+  name  = "%s"
+  email = "%s"
+}
+
+resource "pagerduty_escalation_policy" "foo" {
+  name      = "%s"
+  num_loops = 1
+
+  rule {
+    escalation_delay_in_minutes = 10
+
+    target {
+      type = "user_reference"
+      id   = pagerduty_user.foo.id
+    }
+  }
+}
+
+resource "pagerduty_service" "foo" {
+  name              = "%s"
+  escalation_policy = pagerduty_escalation_policy.foo.id
+}
+
+resource "pagerduty_service_integration" "foo" {
+  name    = "Change events"
+  type    = "events_api_v2_inbound_integration"
+  service = pagerduty_service.foo.id
+}
+
+resource "pagerduty_change_event" "foo" {
+  routing_key = pagerduty_service_integration.foo.integration_key
+  summary     = "Applied version %[5]s"
+  source      = "terraform"
+
+  custom_details = {
+    version = "%[5]s"
+  }
+
+  triggers = {
+    version = "%[5]s"
+  }
+}
+`, username, email, escalationPolicy, service, version)
+}
@@ -0,0 +1,518 @@
+package pagerduty
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/heimweh/go-pagerduty/pagerduty"
+)
+
+// chatConnection is a connection of a PagerDuty service or team to the
+// channel of a chat application, such as Slack or Microsoft Teams.
+type chatConnection struct {
+	ID               string
+	SourceID         string
+	SourceName       string
+	SourceType       string
+	ChannelID        string
+	ChannelName      string
+	NotificationType string
+	Config           pagerduty.ConnectionConfig
+}
+
+// chatConnectionPlatform holds what differs between the connections of the
+// chat applications. Connections belong to a container, which is a workspace
+// in Slack and a team in Microsoft Teams.
+type chatConnectionPlatform struct {
+	// resourceName is the name of the resource, e.g. pagerduty_slack_connection.
+	resourceName string
+	// displayName is used in logs and errors, e.g. Slack connection.
+	displayName string
+	// containerKey is the attribute holding the ID of the container.
+	containerKey string
+	// providerBlock is the block of the provider setting the default
+	// container, if any.
+	providerBlock    string
+	defaultContainer func(*Config) string
+
+	create func(client *pagerduty.Client, containerID string, c *chatConnection) (*chatConnection, error)
+	get    func(client *pagerduty.Client, containerID, id string) (*chatConnection, error)
+	update func(client *pagerduty.Client, containerID, id string, c *chatConnection) error
+	delete func(client *pagerduty.Client, containerID, id string) error
+	list   func(client *pagerduty.Client, containerID string) ([]*chatConnection, error)
+}
+
+var chatConnectionEvents = []string{
+	"incident.acknowledged",
+	"incident.action_invocation.created",
+	"incident.action_invocation.terminated",
+	"incident.action_invocation.updated",
+	"incident.annotated",
+	"incident.delegated",
+	"incident.escalated",
+	"incident.priority_updated",
+	"incident.reassigned",
+	"incident.reopened",
+	"incident.resolved",
+	"incident.responder.added",
+	"incident.responder.replied",
+	"incident.status_update_published",
+	"incident.triggered",
+	"incident.unacknowledged",
+}
+
+func resourcePagerDutyChatConnection(p *chatConnectionPlatform) *schema.Resource {
+	return &schema.Resource{
+		Create: func(d *schema.ResourceData, meta interface{}) error {
+			return resourcePagerDutyChatConnectionCreate(p, d, meta)
+		},
+		Read: func(d *schema.ResourceData, meta interface{}) error {
+			return resourcePagerDutyChatConnectionRead(p, d, meta)
+		},
+		Update: func(d *schema.ResourceData, meta interface{}) error {
+			return resourcePagerDutyChatConnectionUpdate(p, d, meta)
+		},
+		Delete: func(d *schema.ResourceData, meta interface{}) error {
+			return resourcePagerDutyChatConnectionDelete(p, d, meta)
+		},
+		CustomizeDiff: resourcePagerDutyChatConnectionCustomizeDiff,
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				return resourcePagerDutyChatConnectionImport(p, d, meta)
+			},
+		},
+		Schema: map[string]*schema.Schema{
+			"source_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"source_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"source_type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validateValueFunc([]string{
+					"service_reference",
+					"team_reference",
+				}),
+			},
+			"channel_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"channel_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			p.containerKey: {
+				Type:     schema.TypeString,
+				Optional: p.defaultContainer != nil,
+				Required: p.defaultContainer == nil,
+				Computed: p.defaultContainer != nil,
+			},
+			"notification_type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validateValueFunc([]string{
+					"responder",
+					"stakeholder",
+				}),
+			},
+			"config": {
+				Type:     schema.TypeList,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"events": {
+							Type:     schema.TypeList,
+							Required: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validateValueFunc(chatConnectionEvents),
+							},
+						},
+						"priorities": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"urgency": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ValidateFunc: validateValueFunc([]string{
+								"high",
+								"low",
+							}),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourcePagerDutyChatConnectionCustomizeDiff(context context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() != "" && !diff.HasChange("config.0.priorities") {
+		return nil
+	}
+	if !diff.NewValueKnown("config.0.priorities") {
+		return nil
+	}
+
+	// Priorities referenced by name are resolved when the connection is
+	// applied, unknown names are reported while planning.
+	priorities := expandConfigList(diff.Get("config.0.priorities").([]interface{}))
+	if !chatConnectionPrioritiesNeedResolving(priorities) {
+		return nil
+	}
+
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return err
+	}
+	_, err = resolveChatConnectionPriorities(client, priorities)
+	return err
+}
+
+// isChatConnectionPriorityID reports whether a priority is referenced by ID
+// or with "*", which stands for any priority. The names of the default
+// priorities, such as P1, are shorter than IDs.
+func isChatConnectionPriorityID(p string) bool {
+	return p == "*" || (len(p) >= 7 && pagerDutyIDRegexp.MatchString(p))
+}
+
+// chatConnectionPrioritiesNeedResolving reports whether some priorities are
+// referenced by name.
+func chatConnectionPrioritiesNeedResolving(priorities []string) bool {
+	for _, p := range priorities {
+		if !isChatConnectionPriorityID(p) {
+			return true
+		}
+	}
+	return false
+}
+
+// resolveChatConnectionPriorities replaces the priorities referenced by name
+// with their ID.
+func resolveChatConnectionPriorities(client *pagerduty.Client, priorities []string) ([]string, error) {
+	if !chatConnectionPrioritiesNeedResolving(priorities) {
+		return priorities, nil
+	}
+
+	resp, _, err := client.Priorities.List()
+	if err != nil {
+		return nil, err
+	}
+
+	var resolved []string
+	for _, p := range priorities {
+		if isChatConnectionPriorityID(p) {
+			resolved = append(resolved, p)
+			continue
+		}
+
+		var found *pagerduty.Priority
+		var names []string
+		for _, priority := range resp.Priorities {
+			if strings.EqualFold(priority.Name, p) {
+				found = priority
+			}
+			names = append(names, priority.Name)
+		}
+		if found == nil {
+			return nil, fmt.Errorf("%q is neither the ID nor the name of a priority, the priorities of the account are: %s", p, strings.Join(names, ", "))
+		}
+
+		resolved = append(resolved, found.ID)
+	}
+
+	return resolved, nil
+}
+
+// flattenChatConnectionPriorities keeps the priorities of the configuration
+// when they are referenced by name and resolve to the IDs returned by
+// PagerDuty, so that they don't show up as changed.
+func flattenChatConnectionPriorities(meta interface{}, configured, priorities []string) []string {
+	if !chatConnectionPrioritiesNeedResolving(configured) || len(configured) != len(priorities) {
+		return priorities
+	}
+
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return priorities
+	}
+	resolved, err := resolveChatConnectionPriorities(client, configured)
+	if err != nil {
+		log.Printf("[DEBUG] Couldn't resolve the priorities %v: %s", configured, err)
+		return priorities
+	}
+
+	for i := range resolved {
+		if resolved[i] != priorities[i] {
+			return priorities
+		}
+	}
+	return configured
+}
+
+// chatConnectionContainerID returns the container of a connection, which
+// defaults to the one of the provider.
+func chatConnectionContainerID(p *chatConnectionPlatform, d *schema.ResourceData, meta interface{}) (string, error) {
+	if containerID := d.Get(p.containerKey).(string); containerID != "" {
+		return containerID, nil
+	}
+	if p.defaultContainer != nil {
+		if containerID := p.defaultContainer(meta.(*Config)); containerID != "" {
+			return containerID, nil
+		}
+		return "", fmt.Errorf("%s must be set either on the %s or in the %s block of the provider", p.containerKey, p.displayName, p.providerBlock)
+	}
+	return "", fmt.Errorf("%s must be set", p.containerKey)
+}
+
+func buildChatConnectionStruct(d *schema.ResourceData, meta interface{}) (*chatConnection, error) {
+	conn := &chatConnection{
+		SourceID:         d.Get("source_id").(string),
+		SourceName:       d.Get("source_name").(string),
+		SourceType:       d.Get("source_type").(string),
+		ChannelID:        d.Get("channel_id").(string),
+		ChannelName:      d.Get("channel_name").(string),
+		NotificationType: d.Get("notification_type").(string),
+		Config:           expandConnectionConfig(d.Get("config").(interface{})),
+	}
+
+	if chatConnectionPrioritiesNeedResolving(conn.Config.Priorities) {
+		client, err := meta.(*Config).Client()
+		if err != nil {
+			return nil, err
+		}
+		if conn.Config.Priorities, err = resolveChatConnectionPriorities(client, conn.Config.Priorities); err != nil {
+			return nil, err
+		}
+	}
+
+	return conn, nil
+}
+
+func resourcePagerDutyChatConnectionCreate(p *chatConnectionPlatform, d *schema.ResourceData, meta interface{}) error {
+	client, err := meta.(*Config).SlackClient()
+	if err != nil {
+		return err
+	}
+
+	containerID, err := chatConnectionContainerID(p, d, meta)
+	if err != nil {
+		return err
+	}
+
+	retryErr := resource.Retry(2*time.Minute, func() *resource.RetryError {
+
+		conn, err := buildChatConnectionStruct(d, meta)
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		log.Printf("[INFO] Creating PagerDuty %s for source %s and channel %s", p.displayName, conn.SourceID, conn.ChannelID)
+
+		if conn, err = p.create(client, containerID, conn); err != nil {
+			return resource.RetryableError(err)
+		} else if conn != nil {
+			d.SetId(conn.ID)
+			d.Set(p.containerKey, containerID)
+		}
+		return nil
+	})
+	if retryErr != nil {
+		time.Sleep(2 * time.Second)
+		return retryErr
+	}
+	return resourcePagerDutyChatConnectionRead(p, d, meta)
+}
+
+func resourcePagerDutyChatConnectionRead(p *chatConnectionPlatform, d *schema.ResourceData, meta interface{}) error {
+	client, err := meta.(*Config).SlackClient()
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Reading PagerDuty %s %s", p.displayName, d.Id())
+
+	containerID := d.Get(p.containerKey).(string)
+	log.Printf("[DEBUG] Read %s: %s %s", p.displayName, p.containerKey, containerID)
+
+	retryErr := resource.Retry(2*time.Minute, func() *resource.RetryError {
+		if conn, err := p.get(client, containerID, d.Id()); err != nil {
+			if isErrCode(err, 404) {
+				return resource.NonRetryableError(err)
+			}
+			return resource.RetryableError(err)
+		} else if conn != nil {
+			d.Set("source_id", conn.SourceID)
+			d.Set("source_name", conn.SourceName)
+			d.Set("source_type", conn.SourceType)
+			d.Set("channel_id", conn.ChannelID)
+			d.Set("channel_name", conn.ChannelName)
+			d.Set("notification_type", conn.NotificationType)
+			conn.Config.Priorities = flattenChatConnectionPriorities(meta, expandConfigList(d.Get("config.0.priorities")), conn.Config.Priorities)
+			d.Set("config", flattenConnectionConfig(conn.Config))
+		}
+		return nil
+	})
+
+	if retryErr != nil {
+		if isErrCode(retryErr, 404) {
+			return handleNotFoundError(retryErr, d)
+		}
+		time.Sleep(2 * time.Second)
+		return retryErr
+	}
+
+	return nil
+}
+
+func resourcePagerDutyChatConnectionUpdate(p *chatConnectionPlatform, d *schema.ResourceData, meta interface{}) error {
+	client, err := meta.(*Config).SlackClient()
+	if err != nil {
+		return err
+	}
+
+	containerID, err := chatConnectionContainerID(p, d, meta)
+	if err != nil {
+		return err
+	}
+
+	conn, err := buildChatConnectionStruct(d, meta)
+	if err != nil {
+		return err
+	}
+	log.Printf("[INFO] Updating PagerDuty %s %s", p.displayName, d.Id())
+
+	if err := p.update(client, containerID, d.Id(), conn); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func resourcePagerDutyChatConnectionDelete(p *chatConnectionPlatform, d *schema.ResourceData, meta interface{}) error {
+	client, err := meta.(*Config).SlackClient()
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Deleting PagerDuty %s %s", p.displayName, d.Id())
+	containerID := d.Get(p.containerKey).(string)
+
+	if err := p.delete(client, containerID, d.Id()); err != nil {
+		return err
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func resourcePagerDutyChatConnectionImport(p *chatConnectionPlatform, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	client, err := meta.(*Config).SlackClient()
+	if err != nil {
+		return nil, err
+	}
+
+	// The IDs of Microsoft teams contain dots, the ones of connections don't.
+	i := strings.LastIndex(d.Id(), ".")
+
+	if i <= 0 || i == len(d.Id())-1 {
+		return []*schema.ResourceData{}, fmt.Errorf("Error importing %s. Expecting an importation ID formed as '<%s>.<connection_id>'", p.resourceName, p.containerKey)
+	}
+	containerID, connectionID := d.Id()[:i], d.Id()[i+1:]
+
+	if _, err := p.get(client, containerID, connectionID); err != nil {
+		return []*schema.ResourceData{}, err
+	}
+
+	d.SetId(connectionID)
+	d.Set(p.containerKey, containerID)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func expandConnectionConfig(v interface{}) pagerduty.ConnectionConfig {
+	c := v.([]interface{})[0].(map[string]interface{})
+
+	var config pagerduty.ConnectionConfig
+
+	config = pagerduty.ConnectionConfig{
+		Events:     expandConfigList(c["events"].([]interface{})),
+		Priorities: expandConfigList(c["priorities"].([]interface{})),
+		Urgency:    nil,
+	}
+	if val, ok := c["urgency"]; ok {
+		urgency := val.(string)
+		if len(urgency) > 0 {
+			config.Urgency = &urgency
+		}
+	}
+	return config
+}
+
+func expandConfigList(v interface{}) []string {
+	var items []string
+	for _, i := range v.([]interface{}) {
+		items = append(items, i.(string))
+	}
+	return items
+}
+
+func flattenConnectionConfig(config pagerduty.ConnectionConfig) []map[string]interface{} {
+	var configs []map[string]interface{}
+	configMap := map[string]interface{}{
+		"events":     flattenConfigList(config.Events),
+		"priorities": flattenConfigList(config.Priorities),
+	}
+	if config.Urgency != nil {
+		configMap["urgency"] = *config.Urgency
+	}
+	configs = append(configs, configMap)
+	return configs
+}
+
+func flattenConfigList(list []string) interface{} {
+	var items []interface{}
+
+	for _, i := range list {
+		items = append(items, i)
+	}
+
+	return items
+}
+
+// flattenChatConnections flattens the connections of a service or team.
+func flattenChatConnections(connections []*chatConnection, sourceID string) []map[string]interface{} {
+	var flattened []map[string]interface{}
+
+	for _, c := range connections {
+		if c.SourceID != sourceID {
+			continue
+		}
+
+		flattened = append(flattened, map[string]interface{}{
+			"id":                c.ID,
+			"source_name":       c.SourceName,
+			"source_type":       c.SourceType,
+			"channel_id":        c.ChannelID,
+			"channel_name":      c.ChannelName,
+			"notification_type": c.NotificationType,
+			"config":            flattenConnectionConfig(c.Config),
+		})
+	}
+
+	return flattened
+}
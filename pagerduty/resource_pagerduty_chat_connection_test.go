@@ -0,0 +1,212 @@
+package pagerduty
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/heimweh/go-pagerduty/pagerduty"
+)
+
+func TestResolveChatConnectionPriorities(t *testing.T) {
+	var requests int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		json.NewEncoder(w).Encode(&pagerduty.ListPrioritiesResponse{Priorities: []*pagerduty.Priority{
+			{ID: "PP1", Name: "P1"},
+			{ID: "PP2", Name: "P2"},
+		}})
+	}))
+	defer srv.Close()
+
+	client, err := (&Config{Token: "foo", ApiUrlOverride: srv.URL, SkipCredsValidation: true}).Client()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resolved, err := resolveChatConnectionPriorities(client, []string{"*", "PXYZ123"})
+	if err != nil || !reflect.DeepEqual(resolved, []string{"*", "PXYZ123"}) || requests != 0 {
+		t.Errorf("expected IDs to be kept without listing the priorities, got %v, %v after %d requests", resolved, err, requests)
+	}
+
+	resolved, err = resolveChatConnectionPriorities(client, []string{"p1", "PXYZ123", "P2"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(resolved, []string{"PP1", "PXYZ123", "PP2"}) {
+		t.Errorf("unexpected priorities %v", resolved)
+	}
+
+	if _, err := resolveChatConnectionPriorities(client, []string{"Sev1"}); err == nil || !strings.Contains(err.Error(), "P1, P2") {
+		t.Errorf("expected an error listing the priorities, got %v", err)
+	}
+}
+
+func TestPagerDutyChatConnectionCustomizeDiff_Priorities(t *testing.T) {
+	var requests int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		json.NewEncoder(w).Encode(&pagerduty.ListPrioritiesResponse{Priorities: []*pagerduty.Priority{
+			{ID: "PP1ABCD", Name: "P1"},
+			{ID: "PP2ABCD", Name: "P2"},
+		}})
+	}))
+	defer srv.Close()
+
+	config := &Config{Token: "foo", ApiUrlOverride: srv.URL, SkipCredsValidation: true}
+
+	raw := func(priorities ...interface{}) *terraform.ResourceConfig {
+		return terraform.NewResourceConfigRaw(map[string]interface{}{
+			"team_id":           "TTEAM",
+			"source_id":         "PSERVICE",
+			"source_type":       "service_reference",
+			"channel_id":        "C1",
+			"notification_type": "responder",
+			"config": []interface{}{
+				map[string]interface{}{
+					"events":     []interface{}{"incident.triggered"},
+					"priorities": priorities,
+				},
+			},
+		})
+	}
+
+	diff, err := resourcePagerDutyMSTeamsConnection().Diff(context.Background(), nil, raw("P1", "*"), config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a := diff.Attributes["config.0.priorities.0"]; a == nil || a.New != "P1" {
+		t.Errorf("expected the priority to be planned as configured, got %v", a)
+	}
+
+	if _, err := resourcePagerDutyMSTeamsConnection().Diff(context.Background(), nil, raw("Sev1"), config); err == nil || !strings.Contains(err.Error(), "P1, P2") {
+		t.Errorf("expected an error listing the priorities, got %v", err)
+	}
+
+	state := &terraform.InstanceState{ID: "A1", Attributes: map[string]string{
+		"id":                    "A1",
+		"team_id":               "TTEAM",
+		"source_id":             "PSERVICE",
+		"source_type":           "service_reference",
+		"channel_id":            "C1",
+		"notification_type":     "responder",
+		"config.#":              "1",
+		"config.0.events.#":     "1",
+		"config.0.events.0":     "incident.triggered",
+		"config.0.priorities.#": "1",
+		"config.0.priorities.0": "P1",
+		"config.0.urgency":      "",
+	}}
+
+	requests = 0
+	diff, err = resourcePagerDutyMSTeamsConnection().Diff(context.Background(), state, raw("P1"), config)
+	if err != nil || !diff.Empty() || requests != 0 {
+		t.Errorf("expected unchanged priorities not to be resolved again, got %v, %v after %d requests", diff, err, requests)
+	}
+}
+
+func TestPagerDutyMSTeamsConnection_CRUD(t *testing.T) {
+	var created map[string]interface{}
+	var deleted bool
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/priorities":
+			json.NewEncoder(w).Encode(&pagerduty.ListPrioritiesResponse{Priorities: []*pagerduty.Priority{{ID: "PP1ABCD", Name: "P1"}}})
+		case r.Method == "POST" && r.URL.Path == "/integration-msteams/teams/TTEAM/connections":
+			b, _ := ioutil.ReadAll(r.Body)
+			if err := json.Unmarshal(b, &created); err != nil {
+				t.Fatal(err)
+			}
+			w.WriteHeader(http.StatusCreated)
+			w.Write([]byte(`{"msteams_connection":{"id":"A1"}}`))
+		case r.Method == "GET" && r.URL.Path == "/integration-msteams/teams/TTEAM/connections/A1":
+			w.Write([]byte(`{"msteams_connection":{"id":"A1","source_id":"PSERVICE","source_name":"Foo","source_type":"service_reference","channel_id":"C1","channel_name":"alerts","notification_type":"responder","config":{"events":["incident.triggered"],"priorities":["PP1ABCD"],"urgency":null}}}`))
+		case r.Method == "DELETE" && r.URL.Path == "/integration-msteams/teams/TTEAM/connections/A1":
+			deleted = true
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	}))
+	defer srv.Close()
+
+	d := schema.TestResourceDataRaw(t, resourcePagerDutyMSTeamsConnection().Schema, map[string]interface{}{
+		"team_id":           "TTEAM",
+		"source_id":         "PSERVICE",
+		"source_type":       "service_reference",
+		"channel_id":        "C1",
+		"notification_type": "responder",
+		"config": []interface{}{
+			map[string]interface{}{
+				"events":     []interface{}{"incident.triggered"},
+				"priorities": []interface{}{"P1"},
+			},
+		},
+	})
+
+	config := &Config{Token: "foo", UserToken: "bar", ApiUrlOverride: srv.URL, AppUrlOverride: srv.URL, SkipCredsValidation: true}
+	if err := resourcePagerDutyMSTeamsConnection().Create(d, config); err != nil {
+		t.Fatal(err)
+	}
+
+	conn := created["msteams_connection"].(map[string]interface{})
+	if conn["team_id"] != "TTEAM" || conn["channel_id"] != "C1" {
+		t.Errorf("unexpected connection %v", conn)
+	}
+	if priorities := conn["config"].(map[string]interface{})["priorities"]; !reflect.DeepEqual(priorities, []interface{}{"PP1ABCD"}) {
+		t.Errorf("expected the priority to be resolved, got %v", priorities)
+	}
+	if priorities := d.Get("config.0.priorities").([]interface{}); !reflect.DeepEqual(priorities, []interface{}{"P1"}) {
+		t.Errorf("expected the priority to be kept by name, got %v", priorities)
+	}
+
+	if d.Id() != "A1" || d.Get("channel_name").(string) != "alerts" || d.Get("source_name").(string) != "Foo" {
+		t.Errorf("unexpected state %q, %q, %q", d.Id(), d.Get("channel_name"), d.Get("source_name"))
+	}
+
+	if err := resourcePagerDutyMSTeamsConnection().Delete(d, config); err != nil {
+		t.Fatal(err)
+	}
+	if !deleted || d.Id() != "" {
+		t.Error("expected the connection to be deleted")
+	}
+}
+
+func TestPagerDutyMSTeamsConnection_ImportID(t *testing.T) {
+	teamID := "19:a1b2c3d4e5f6@thread.tacv2"
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "GET" || r.URL.Path != "/integration-msteams/teams/"+teamID+"/connections/A1" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.Write([]byte(`{"msteams_connection":{"id":"A1","source_id":"PSERVICE","source_type":"service_reference","channel_id":"C1","notification_type":"responder","config":{"events":["incident.triggered"]}}}`))
+	}))
+	defer srv.Close()
+
+	config := &Config{Token: "foo", UserToken: "bar", ApiUrlOverride: srv.URL, AppUrlOverride: srv.URL, SkipCredsValidation: true}
+
+	d := resourcePagerDutyMSTeamsConnection().TestResourceData()
+	d.SetId(teamID + ".A1")
+	if _, err := resourcePagerDutyMSTeamsConnection().Importer.State(d, config); err != nil {
+		t.Fatal(err)
+	}
+	if d.Id() != "A1" || d.Get("team_id").(string) != teamID {
+		t.Errorf("unexpected state %q, %q", d.Id(), d.Get("team_id"))
+	}
+
+	d.SetId("A1")
+	if _, err := resourcePagerDutyMSTeamsConnection().Importer.State(d, config); err == nil {
+		t.Error("expected an error importing an ID without team")
+	}
+}
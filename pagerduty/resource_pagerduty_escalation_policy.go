@@ -3,6 +3,7 @@ package pagerduty
 import (
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -57,6 +58,26 @@ func resourcePagerDutyEscalationPolicy() *schema.Resource {
 							Required:     true,
 							ValidateFunc: validation.IntAtLeast(1),
 						},
+						"escalation_rule_assignment_strategy": {
+							Type:             schema.TypeList,
+							Optional:         true,
+							MaxItems:         1,
+							DiffSuppressFunc: suppressDefaultAssignmentStrategyDiff,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"type": {
+										Type:             schema.TypeString,
+										Optional:         true,
+										Default:          "assign_to_everyone",
+										DiffSuppressFunc: suppressDefaultAssignmentStrategyDiff,
+										ValidateFunc: validateValueFunc([]string{
+											"assign_to_everyone",
+											"round_robin",
+										}),
+									},
+								},
+							},
+						},
 						"target": {
 							Type:     schema.TypeList,
 							Required: true,
@@ -77,6 +98,7 @@ func resourcePagerDutyEscalationPolicy() *schema.Resource {
 					},
 				},
 			},
+			"deletion_protection": deletionProtectionSchema(),
 		},
 	}
 }
@@ -123,6 +145,8 @@ func resourcePagerDutyEscalationPolicyRead(d *schema.ResourceData, meta interfac
 
 	log.Printf("[INFO] Reading PagerDuty escalation policy: %s", d.Id())
 
+	setDefaultDeletionProtection(d)
+
 	o := &pagerduty.GetEscalationPolicyOptions{}
 
 	return resource.Retry(2*time.Minute, func() *resource.RetryError {
@@ -170,24 +194,33 @@ func resourcePagerDutyEscalationPolicyUpdate(d *schema.ResourceData, meta interf
 }
 
 func resourcePagerDutyEscalationPolicyDelete(d *schema.ResourceData, meta interface{}) error {
-	client, _ := meta.(*Config).Client()
+	if err := checkDeletionProtection(d, "pagerduty_escalation_policy"); err != nil {
+		return err
+	}
 
-	log.Printf("[INFO] Deleting PagerDuty escalation policy: %s", d.Id())
+	client, _ := meta.(*Config).Client()
 
-	// Retrying to give other resources (such as services) to delete
-	retryErr := resource.Retry(30*time.Second, func() *resource.RetryError {
-		if _, err := client.EscalationPolicies.Delete(d.Id()); err != nil {
-			if isErrCode(err, 400) {
-				return resource.RetryableError(err)
-			}
+	// Services can't exist without an escalation policy, so instead of retrying
+	// until they are gone we fail straight away with the services that remain.
+	services, err := fetchEscalationPolicyServices(client, d.Id())
+	if err != nil {
+		return err
+	}
 
-			return resource.NonRetryableError(err)
+	if len(services) > 0 {
+		var dependents []string
+		for _, s := range services {
+			dependents = append(dependents, fmt.Sprintf("service %q (%s)", s.Name, s.ID))
 		}
-		return nil
-	})
-	if retryErr != nil {
-		time.Sleep(2 * time.Second)
-		return retryErr
+		return fmt.Errorf("pagerduty_escalation_policy %q (%s) can't be deleted because it is used by:\n%s\nAssign another escalation policy to these services first", d.Get("name").(string), d.Id(), formatDependents(dependents))
+	}
+
+	log.Printf("[INFO] Deleting PagerDuty escalation policy: %s", d.Id())
+
+	// The services using the escalation policy were checked above, so an
+	// escalation policy that still can't be deleted is reported right away.
+	if _, err := client.EscalationPolicies.Delete(d.Id()); err != nil {
+		return err
 	}
 
 	d.SetId("")
@@ -197,6 +230,93 @@ func resourcePagerDutyEscalationPolicyDelete(d *schema.ResourceData, meta interf
 	return nil
 }
 
+// fetchEscalationPolicyServices returns the services that still use the escalation policy.
+// Services that have been deleted in the meantime are skipped.
+func fetchEscalationPolicyServices(client *pagerduty.Client, id string) ([]*pagerduty.Service, error) {
+	escalationPolicy, _, err := client.EscalationPolicies.Get(id, &pagerduty.GetEscalationPolicyOptions{})
+	if err != nil {
+		if isErrCode(err, 404) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var services []*pagerduty.Service
+	for _, ref := range escalationPolicy.Services {
+		service, _, err := client.Services.Get(ref.ID, &pagerduty.GetServiceOptions{})
+		if err != nil {
+			if isErrCode(err, 404) {
+				continue
+			}
+			return nil, err
+		}
+		if service.EscalationPolicy != nil && service.EscalationPolicy.ID == id {
+			services = append(services, service)
+		}
+	}
+
+	return services, nil
+}
+
+// replaceEscalationPolicyTarget replaces every targetType target with the
+// given ID by replacement. A nil replacement removes the target instead, and
+// escalation rules that are left without targets are dropped.
+func replaceEscalationPolicyTarget(client *pagerduty.Client, escalationPolicy *pagerduty.EscalationPolicy, targetType, targetID string, replacement *pagerduty.EscalationTargetReference) error {
+	var rules []*pagerduty.EscalationRule
+
+	for _, rule := range escalationPolicy.EscalationRules {
+		var targets []*pagerduty.EscalationTargetReference
+		for _, t := range rule.Targets {
+			if t.Type != targetType || t.ID != targetID {
+				targets = append(targets, t)
+				continue
+			}
+
+			if replacement == nil {
+				continue
+			}
+
+			log.Printf("[INFO] Replacing %s %s with %s %s in escalation rule %s of PagerDuty escalation policy %s", targetType, targetID, replacement.Type, replacement.ID, rule.ID, escalationPolicy.ID)
+
+			if !hasEscalationTarget(rule.Targets, replacement) {
+				targets = append(targets, replacement)
+			}
+		}
+
+		if len(targets) == 0 {
+			log.Printf("[INFO] Dropping escalation rule %s from PagerDuty escalation policy %s as %s %s was its only target", rule.ID, escalationPolicy.ID, targetType, targetID)
+			continue
+		}
+
+		rule.Targets = targets
+		rules = append(rules, rule)
+	}
+
+	if len(rules) == 0 {
+		return fmt.Errorf("escalation policy %q (%s) would be left without any escalation rules if %s %s was removed from it", escalationPolicy.Name, escalationPolicy.ID, targetType, targetID)
+	}
+
+	if replacement == nil {
+		log.Printf("[INFO] Removing %s %s from PagerDuty escalation policy %s", targetType, targetID, escalationPolicy.ID)
+	}
+
+	escalationPolicy.EscalationRules = rules
+	if _, _, err := client.EscalationPolicies.Update(escalationPolicy.ID, escalationPolicy); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func hasEscalationTarget(targets []*pagerduty.EscalationTargetReference, target *pagerduty.EscalationTargetReference) bool {
+	for _, t := range targets {
+		if t.Type == target.Type && t.ID == target.ID {
+			return true
+		}
+	}
+	return false
+}
+
 func expandEscalationRules(v interface{}) []*pagerduty.EscalationRule {
 	var escalationRules []*pagerduty.EscalationRule
 
@@ -206,6 +326,16 @@ func expandEscalationRules(v interface{}) []*pagerduty.EscalationRule {
 			EscalationDelayInMinutes: rer["escalation_delay_in_minutes"].(int),
 		}
 
+		// The strategy is always sent so that removing the block resets
+		// the rule to the default strategy.
+		escalationRule.EscalationRuleAssignmentStrategy = &pagerduty.EscalationRuleAssignmentStrategy{
+			Type: "assign_to_everyone",
+		}
+		if strategies, ok := rer["escalation_rule_assignment_strategy"].([]interface{}); ok && len(strategies) > 0 && strategies[0] != nil {
+			strategy := strategies[0].(map[string]interface{})
+			escalationRule.EscalationRuleAssignmentStrategy.Type = strategy["type"].(string)
+		}
+
 		for _, ert := range rer["target"].([]interface{}) {
 			rert := ert.(map[string]interface{})
 			escalationRuleTarget := &pagerduty.EscalationTargetReference{
@@ -240,6 +370,12 @@ func flattenEscalationRules(v []*pagerduty.EscalationRule) []map[string]interfac
 
 		escalationRule["target"] = targets
 
+		if er.EscalationRuleAssignmentStrategy != nil {
+			escalationRule["escalation_rule_assignment_strategy"] = []map[string]interface{}{
+				{"type": er.EscalationRuleAssignmentStrategy.Type},
+			}
+		}
+
 		escalationRules = append(escalationRules, escalationRule)
 	}
 
@@ -268,3 +404,20 @@ func flattenTeams(teams []*pagerduty.TeamReference) []string {
 
 	return res
 }
+
+// suppressDefaultAssignmentStrategyDiff suppresses the diff between a rule
+// without escalation_rule_assignment_strategy and one using the default
+// strategy, which the API returns for every rule.
+func suppressDefaultAssignmentStrategyDiff(k, old, new string, d *schema.ResourceData) bool {
+	prefix := k[:strings.LastIndex(k, ".escalation_rule_assignment_strategy")]
+	o, n := d.GetChange(prefix + ".escalation_rule_assignment_strategy.0.type")
+
+	defaultType := func(v interface{}) string {
+		if t, _ := v.(string); t != "" {
+			return t
+		}
+		return "assign_to_everyone"
+	}
+
+	return defaultType(o) == defaultType(n)
+}
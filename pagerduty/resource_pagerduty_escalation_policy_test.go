@@ -1,6 +1,7 @@
 package pagerduty
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -154,6 +155,119 @@ func TestAccPagerDutyEscalationPolicyWithTeams_Basic(t *testing.T) {
 	})
 }
 
+func TestAccPagerDutyEscalationPolicy_AssignmentStrategy(t *testing.T) {
+	username := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	email := fmt.Sprintf("%s@foo.com", username)
+	escalationPolicy := fmt.Sprintf("tf-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPagerDutyEscalationPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckPagerDutyEscalationPolicyConfig(username, email, escalationPolicy),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyEscalationPolicyExists("pagerduty_escalation_policy.foo"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_escalation_policy.foo", "rule.0.escalation_rule_assignment_strategy.0.type", "assign_to_everyone"),
+				),
+			},
+
+			{
+				Config: testAccCheckPagerDutyEscalationPolicyAssignmentStrategyConfig(username, email, escalationPolicy, "round_robin"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyEscalationPolicyExists("pagerduty_escalation_policy.foo"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_escalation_policy.foo", "rule.0.escalation_rule_assignment_strategy.0.type", "round_robin"),
+				),
+			},
+
+			{
+				Config: testAccCheckPagerDutyEscalationPolicyConfig(username, email, escalationPolicy),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyEscalationPolicyExists("pagerduty_escalation_policy.foo"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_escalation_policy.foo", "rule.0.escalation_rule_assignment_strategy.0.type", "assign_to_everyone"),
+				),
+			},
+
+			{
+				Config: testAccCheckPagerDutyEscalationPolicyAssignmentStrategyConfig(username, email, escalationPolicy, "assign_to_everyone"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyEscalationPolicyExists("pagerduty_escalation_policy.foo"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_escalation_policy.foo", "rule.0.escalation_rule_assignment_strategy.0.type", "assign_to_everyone"),
+				),
+			},
+		},
+	})
+}
+
+func TestPagerDutyEscalationPolicyAssignmentStrategyDiff(t *testing.T) {
+	config := func(strategy string) *terraform.ResourceConfig {
+		rule := map[string]interface{}{
+			"escalation_delay_in_minutes": 10,
+			"target":                      []interface{}{map[string]interface{}{"id": "PUSER01"}},
+		}
+		if strategy != "" {
+			rule["escalation_rule_assignment_strategy"] = []interface{}{map[string]interface{}{"type": strategy}}
+		}
+		return terraform.NewResourceConfigRaw(map[string]interface{}{
+			"name": "foo",
+			"rule": []interface{}{rule},
+		})
+	}
+	state := func(strategy string) *terraform.InstanceState {
+		return &terraform.InstanceState{
+			ID: "PEP0001",
+			Attributes: map[string]string{
+				"id":                                 "PEP0001",
+				"name":                               "foo",
+				"description":                        "Managed by Terraform",
+				"rule.#":                             "1",
+				"rule.0.id":                          "PRULE01",
+				"rule.0.escalation_delay_in_minutes": "10",
+				"rule.0.escalation_rule_assignment_strategy.#":      "1",
+				"rule.0.escalation_rule_assignment_strategy.0.type": strategy,
+				"rule.0.target.#":      "1",
+				"rule.0.target.0.id":   "PUSER01",
+				"rule.0.target.0.type": "user_reference",
+			},
+		}
+	}
+
+	cases := []struct {
+		state, config string
+		wantDiff      bool
+	}{
+		{"assign_to_everyone", "", false},
+		{"assign_to_everyone", "assign_to_everyone", false},
+		{"round_robin", "round_robin", false},
+		{"round_robin", "", true},
+		{"assign_to_everyone", "round_robin", true},
+	}
+
+	for _, c := range cases {
+		diff, err := resourcePagerDutyEscalationPolicy().Diff(context.Background(), state(c.state), config(c.config), nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var changed []string
+		if diff != nil {
+			for k := range diff.Attributes {
+				if strings.Contains(k, "escalation_rule_assignment_strategy") {
+					changed = append(changed, k)
+				}
+			}
+		}
+		if got := len(changed) > 0; got != c.wantDiff {
+			t.Errorf("%s to %q: expected a diff %t, got %v", c.state, c.config, c.wantDiff, changed)
+		}
+	}
+}
+
 func testAccCheckPagerDutyEscalationPolicyDestroy(s *terraform.State) error {
 	client, _ := testAccProvider.Meta().(*Config).Client()
 	for _, r := range s.RootModule().Resources {
@@ -334,3 +448,35 @@ resource "pagerduty_escalation_policy" "foo" {
 }
 `, name, email, team, escalationPolicy)
 }
+
+func testAccCheckPagerDutyEscalationPolicyAssignmentStrategyConfig(name, email, escalationPolicy, strategy string) string {
+	return fmt.Sprintf(`
+resource "pagerduty_user" "foo" {
+  name        = "%s"
+  email       = "%s"
+  color       = "green"
+  role        = "user"
+  job_title   = "foo"
+  description = "foo"
+}
+
+resource "pagerduty_escalation_policy" "foo" {
+  name        = "%s"
+  description = "foo"
+  num_loops   = 1
+
+  rule {
+    escalation_delay_in_minutes = 10
+
+    escalation_rule_assignment_strategy {
+      type = "%s"
+    }
+
+    target {
+      type = "user_reference"
+      id   = pagerduty_user.foo.id
+    }
+  }
+}
+`, name, email, escalationPolicy, strategy)
+}
@@ -0,0 +1,196 @@
+package pagerduty
+
+import (
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/heimweh/go-pagerduty/pagerduty"
+)
+
+func resourcePagerDutyEventOrchestration() *schema.Resource {
+	return &schema.Resource{
+		Create: resourcePagerDutyEventOrchestrationCreate,
+		Read:   resourcePagerDutyEventOrchestrationRead,
+		Update: resourcePagerDutyEventOrchestrationUpdate,
+		Delete: resourcePagerDutyEventOrchestrationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"team": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"integration": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"parameters": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"routing_key": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"type": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func buildEventOrchestrationStruct(d *schema.ResourceData) *pagerduty.EventOrchestration {
+	orchestration := &pagerduty.EventOrchestration{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+	}
+
+	if attr, ok := d.GetOk("team"); ok {
+		orchestration.Team = &pagerduty.EventOrchestrationObject{
+			ID: attr.(string),
+		}
+	}
+
+	return orchestration
+}
+
+func flattenEventOrchestrationIntegrations(integrations []*pagerduty.EventOrchestrationIntegration) []interface{} {
+	var flattened []interface{}
+
+	for _, i := range integrations {
+		var parameters []interface{}
+		if i.Parameters != nil {
+			parameters = append(parameters, map[string]interface{}{
+				"routing_key": i.Parameters.RoutingKey,
+				"type":        i.Parameters.Type,
+			})
+		}
+
+		flattened = append(flattened, map[string]interface{}{
+			"id":         i.ID,
+			"parameters": parameters,
+		})
+	}
+
+	return flattened
+}
+
+func setEventOrchestrationState(d *schema.ResourceData, orchestration *pagerduty.EventOrchestration) error {
+	d.Set("name", orchestration.Name)
+	d.Set("description", orchestration.Description)
+
+	if orchestration.Team != nil {
+		d.Set("team", orchestration.Team.ID)
+	} else {
+		d.Set("team", "")
+	}
+
+	return d.Set("integration", flattenEventOrchestrationIntegrations(orchestration.Integrations))
+}
+
+func fetchPagerDutyEventOrchestration(d *schema.ResourceData, meta interface{}, errCallback func(error, *schema.ResourceData) error) error {
+	client, _ := meta.(*Config).Client()
+
+	return resource.Retry(2*time.Minute, func() *resource.RetryError {
+		orchestration, _, err := client.EventOrchestrations.Get(d.Id())
+		if err != nil {
+			errResp := errCallback(err, d)
+			if errResp != nil {
+				time.Sleep(2 * time.Second)
+				return resource.RetryableError(errResp)
+			}
+
+			return nil
+		}
+
+		if err := setEventOrchestrationState(d, orchestration); err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+}
+
+func resourcePagerDutyEventOrchestrationCreate(d *schema.ResourceData, meta interface{}) error {
+	client, _ := meta.(*Config).Client()
+
+	orchestration := buildEventOrchestrationStruct(d)
+
+	log.Printf("[INFO] Creating PagerDuty event orchestration: %s", orchestration.Name)
+
+	retryErr := resource.Retry(10*time.Second, func() *resource.RetryError {
+		if orchestration, _, err := client.EventOrchestrations.Create(orchestration); err != nil {
+			if isErrCode(err, 400) || isErrCode(err, 429) {
+				return resource.RetryableError(err)
+			}
+
+			return resource.NonRetryableError(err)
+		} else if orchestration != nil {
+			d.SetId(orchestration.ID)
+		}
+		return nil
+	})
+
+	if retryErr != nil {
+		return retryErr
+	}
+
+	return fetchPagerDutyEventOrchestration(d, meta, genError)
+}
+
+func resourcePagerDutyEventOrchestrationRead(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[INFO] Reading PagerDuty event orchestration: %s", d.Id())
+	return fetchPagerDutyEventOrchestration(d, meta, handleNotFoundError)
+}
+
+func resourcePagerDutyEventOrchestrationUpdate(d *schema.ResourceData, meta interface{}) error {
+	client, _ := meta.(*Config).Client()
+
+	orchestration := buildEventOrchestrationStruct(d)
+
+	log.Printf("[INFO] Updating PagerDuty event orchestration: %s", d.Id())
+
+	if _, _, err := client.EventOrchestrations.Update(d.Id(), orchestration); err != nil {
+		return err
+	}
+
+	return fetchPagerDutyEventOrchestration(d, meta, genError)
+}
+
+func resourcePagerDutyEventOrchestrationDelete(d *schema.ResourceData, meta interface{}) error {
+	client, _ := meta.(*Config).Client()
+
+	log.Printf("[INFO] Deleting PagerDuty event orchestration: %s", d.Id())
+
+	if _, err := client.EventOrchestrations.Delete(d.Id()); err != nil {
+		return err
+	}
+
+	d.SetId("")
+
+	return nil
+}
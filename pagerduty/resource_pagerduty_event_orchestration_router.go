@@ -0,0 +1,116 @@
+package pagerduty
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/heimweh/go-pagerduty/pagerduty"
+)
+
+func eventOrchestrationRouterActionsSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"route_to": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+	}
+}
+
+func resourcePagerDutyEventOrchestrationRouter() *schema.Resource {
+	set := eventOrchestrationPathSetSchema(eventOrchestrationRouterActionsSchema())
+	// The router only has the start set.
+	set.MaxItems = 1
+
+	return &schema.Resource{
+		Create: resourcePagerDutyEventOrchestrationRouterUpdate,
+		Read:   resourcePagerDutyEventOrchestrationRouterRead,
+		Update: resourcePagerDutyEventOrchestrationRouterUpdate,
+		Delete: resourcePagerDutyEventOrchestrationRouterDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourcePagerDutyEventOrchestrationPathImport("event_orchestration"),
+		},
+		Schema: map[string]*schema.Schema{
+			"event_orchestration": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"set":       set,
+			"catch_all": eventOrchestrationPathCatchAllSchema(eventOrchestrationRouterActionsSchema()),
+		},
+	}
+}
+
+func buildEventOrchestrationRouterStruct(d *schema.ResourceData) (*pagerduty.EventOrchestrationPath, error) {
+	sets := expandEventOrchestrationPathSets(d.Get("set"))
+	for _, s := range sets {
+		if s.ID != "start" {
+			return nil, fmt.Errorf("the router of an event orchestration only has the \"start\" set, got %q", s.ID)
+		}
+	}
+
+	return &pagerduty.EventOrchestrationPath{
+		Type:     pagerduty.PathTypeRouter,
+		Sets:     sets,
+		CatchAll: expandEventOrchestrationPathCatchAll(d.Get("catch_all")),
+	}, nil
+}
+
+func resourcePagerDutyEventOrchestrationRouterRead(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[INFO] Reading PagerDuty event orchestration router: %s", d.Id())
+
+	d.Set("event_orchestration", d.Id())
+
+	return fetchEventOrchestrationPath(d, meta, pagerduty.PathTypeRouter, eventOrchestrationRouterActionsSchema(), eventOrchestrationRouterActionsSchema(), handleNotFoundError)
+}
+
+func resourcePagerDutyEventOrchestrationRouterUpdate(d *schema.ResourceData, meta interface{}) error {
+	client, _ := meta.(*Config).Client()
+
+	router, err := buildEventOrchestrationRouterStruct(d)
+	if err != nil {
+		return err
+	}
+
+	id := d.Get("event_orchestration").(string)
+
+	if err := updateEventOrchestrationPath(client, id, pagerduty.PathTypeRouter, router); err != nil {
+		return err
+	}
+
+	d.SetId(id)
+
+	return fetchEventOrchestrationPath(d, meta, pagerduty.PathTypeRouter, eventOrchestrationRouterActionsSchema(), eventOrchestrationRouterActionsSchema(), genError)
+}
+
+func resourcePagerDutyEventOrchestrationRouterDelete(d *schema.ResourceData, meta interface{}) error {
+	client, _ := meta.(*Config).Client()
+
+	// Routing every event to the unrouted rules is what a new event
+	// orchestration starts with.
+	empty := emptyEventOrchestrationPath(&pagerduty.EventOrchestrationPathRuleActions{
+		RouteTo: "unrouted",
+	})
+
+	if err := updateEventOrchestrationPath(client, d.Id(), pagerduty.PathTypeRouter, empty); err != nil {
+		if isErrCode(err, 404) {
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+// resourcePagerDutyEventOrchestrationPathImport imports a path by the ID of
+// its parent, which is also stored in parentKey.
+func resourcePagerDutyEventOrchestrationPathImport(parentKey string) schema.StateFunc {
+	return func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+		d.Set(parentKey, d.Id())
+		return []*schema.ResourceData{d}, nil
+	}
+}
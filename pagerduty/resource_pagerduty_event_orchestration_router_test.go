@@ -0,0 +1,137 @@
+package pagerduty
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/heimweh/go-pagerduty/pagerduty"
+)
+
+func TestAccPagerDutyEventOrchestrationRouter_Basic(t *testing.T) {
+	name := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	service := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	username := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	email := fmt.Sprintf("%s@foo.com", username)
+	escalationPolicy := fmt.Sprintf("tf-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPagerDutyEventOrchestrationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckPagerDutyEventOrchestrationRouterConfig(name, service, username, email, escalationPolicy),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyEventOrchestrationPathRuleCount("pagerduty_event_orchestration_router.router", pagerduty.PathTypeRouter, 1),
+					resource.TestCheckResourceAttr(
+						"pagerduty_event_orchestration_router.router", "set.0.id", "start"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_event_orchestration_router.router", "set.0.rule.0.label", "database events"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_event_orchestration_router.router", "set.0.rule.0.condition.0.expression", "event.summary matches part 'database'"),
+					resource.TestCheckResourceAttrPair(
+						"pagerduty_event_orchestration_router.router", "set.0.rule.0.actions.0.route_to", "pagerduty_service.foo", "id"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_event_orchestration_router.router", "catch_all.0.actions.0.route_to", "unrouted"),
+				),
+			},
+			{
+				Config: testAccCheckPagerDutyEventOrchestrationRouterConfigRemoved(name, service, username, email, escalationPolicy),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyEventOrchestrationPathRuleCount("pagerduty_event_orchestration.foo", pagerduty.PathTypeRouter, 0),
+				),
+			},
+		},
+	})
+}
+
+// testAccCheckPagerDutyEventOrchestrationPathRuleCount checks the number of
+// rules in the path of the given type that belongs to the ID of n.
+func testAccCheckPagerDutyEventOrchestrationPathRuleCount(n, pathType string, count int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		client, _ := testAccProvider.Meta().(*Config).Client()
+		path, _, err := client.EventOrchestrations.GetPath(rs.Primary.ID, pathType)
+		if err != nil {
+			return err
+		}
+
+		rules := 0
+		for _, set := range path.Sets {
+			rules += len(set.Rules)
+		}
+
+		if rules != count {
+			return fmt.Errorf("Expected %d rules in the %s path of %s, got %d", count, pathType, rs.Primary.ID, rules)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckPagerDutyEventOrchestrationRouterServiceConfig(name, service, username, email, escalationPolicy string) string {
+	return fmt.Sprintf(`
+resource "pagerduty_event_orchestration" "foo" {
+  name = "%s"
+}
+
+resource "pagerduty_user" "foo" {
+  name  = "%s"
+  email = "%s"
+}
+
+resource "pagerduty_escalation_policy" "foo" {
+  name = "%s"
+  rule {
+    escalation_delay_in_minutes = 10
+    target {
+      type = "user_reference"
+      id   = pagerduty_user.foo.id
+    }
+  }
+}
+
+resource "pagerduty_service" "foo" {
+  name              = "%s"
+  escalation_policy = pagerduty_escalation_policy.foo.id
+}
+`, name, username, email, escalationPolicy, service)
+}
+
+func testAccCheckPagerDutyEventOrchestrationRouterConfig(name, service, username, email, escalationPolicy string) string {
+	return testAccCheckPagerDutyEventOrchestrationRouterServiceConfig(name, service, username, email, escalationPolicy) + `
+resource "pagerduty_event_orchestration_router" "router" {
+  event_orchestration = pagerduty_event_orchestration.foo.id
+
+  set {
+    id = "start"
+    rule {
+      label = "database events"
+      condition {
+        expression = "event.summary matches part 'database'"
+      }
+      actions {
+        route_to = pagerduty_service.foo.id
+      }
+    }
+  }
+
+  catch_all {
+    actions {
+      route_to = "unrouted"
+    }
+  }
+}
+`
+}
+
+func testAccCheckPagerDutyEventOrchestrationRouterConfigRemoved(name, service, username, email, escalationPolicy string) string {
+	return testAccCheckPagerDutyEventOrchestrationRouterServiceConfig(name, service, username, email, escalationPolicy)
+}
@@ -0,0 +1,166 @@
+package pagerduty
+
+import (
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/heimweh/go-pagerduty/pagerduty"
+)
+
+func eventOrchestrationServiceCatchAllActionsSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"suppress": {
+			Type:     schema.TypeBool,
+			Optional: true,
+		},
+		"suspend": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntAtLeast(0),
+		},
+		"priority": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"annotate": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"pagerduty_automation_action": {
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"action_id": {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+				},
+			},
+		},
+		"automation_action": {
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"name": {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+					"url": {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+					"auto_send": {
+						Type:     schema.TypeBool,
+						Optional: true,
+					},
+					"header":    eventOrchestrationServiceAutomationActionObjectSchema(),
+					"parameter": eventOrchestrationServiceAutomationActionObjectSchema(),
+				},
+			},
+		},
+		"severity":     eventOrchestrationPathSeveritySchema(),
+		"event_action": eventOrchestrationPathEventActionSchema(),
+		"variable":     eventOrchestrationPathVariableSchema(),
+		"extraction":   eventOrchestrationPathExtractionSchema(),
+	}
+}
+
+func eventOrchestrationServiceRuleActionsSchema() map[string]*schema.Schema {
+	actions := eventOrchestrationServiceCatchAllActionsSchema()
+	actions["route_to"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+	}
+	return actions
+}
+
+func eventOrchestrationServiceAutomationActionObjectSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"key": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				"value": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+			},
+		},
+	}
+}
+
+func resourcePagerDutyEventOrchestrationService() *schema.Resource {
+	return &schema.Resource{
+		Create: resourcePagerDutyEventOrchestrationServiceUpdate,
+		Read:   resourcePagerDutyEventOrchestrationServiceRead,
+		Update: resourcePagerDutyEventOrchestrationServiceUpdate,
+		Delete: resourcePagerDutyEventOrchestrationServiceDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourcePagerDutyEventOrchestrationPathImport("service"),
+		},
+		Schema: map[string]*schema.Schema{
+			"service": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"set":       eventOrchestrationPathSetSchema(eventOrchestrationServiceRuleActionsSchema()),
+			"catch_all": eventOrchestrationPathCatchAllSchema(eventOrchestrationServiceCatchAllActionsSchema()),
+		},
+	}
+}
+
+func buildEventOrchestrationServiceStruct(d *schema.ResourceData) *pagerduty.EventOrchestrationPath {
+	return &pagerduty.EventOrchestrationPath{
+		Type:     pagerduty.PathTypeService,
+		Sets:     expandEventOrchestrationPathSets(d.Get("set")),
+		CatchAll: expandEventOrchestrationPathCatchAll(d.Get("catch_all")),
+	}
+}
+
+func resourcePagerDutyEventOrchestrationServiceRead(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[INFO] Reading PagerDuty event orchestration rules of service: %s", d.Id())
+
+	d.Set("service", d.Id())
+
+	return fetchEventOrchestrationPath(d, meta, pagerduty.PathTypeService, eventOrchestrationServiceRuleActionsSchema(), eventOrchestrationServiceCatchAllActionsSchema(), handleNotFoundError)
+}
+
+func resourcePagerDutyEventOrchestrationServiceUpdate(d *schema.ResourceData, meta interface{}) error {
+	client, _ := meta.(*Config).Client()
+
+	id := d.Get("service").(string)
+
+	if err := updateEventOrchestrationPath(client, id, pagerduty.PathTypeService, buildEventOrchestrationServiceStruct(d)); err != nil {
+		return err
+	}
+
+	d.SetId(id)
+
+	return fetchEventOrchestrationPath(d, meta, pagerduty.PathTypeService, eventOrchestrationServiceRuleActionsSchema(), eventOrchestrationServiceCatchAllActionsSchema(), genError)
+}
+
+func resourcePagerDutyEventOrchestrationServiceDelete(d *schema.ResourceData, meta interface{}) error {
+	client, _ := meta.(*Config).Client()
+
+	empty := emptyEventOrchestrationPath(&pagerduty.EventOrchestrationPathRuleActions{})
+
+	if err := updateEventOrchestrationPath(client, d.Id(), pagerduty.PathTypeService, empty); err != nil {
+		if isErrCode(err, 404) {
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	d.SetId("")
+
+	return nil
+}
@@ -0,0 +1,108 @@
+package pagerduty
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/heimweh/go-pagerduty/pagerduty"
+)
+
+func TestAccPagerDutyEventOrchestrationService_Basic(t *testing.T) {
+	service := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	username := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	email := fmt.Sprintf("%s@foo.com", username)
+	escalationPolicy := fmt.Sprintf("tf-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPagerDutyServiceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckPagerDutyEventOrchestrationServiceConfig(service, username, email, escalationPolicy),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyEventOrchestrationPathRuleCount("pagerduty_event_orchestration_service.serviceA", pagerduty.PathTypeService, 1),
+					resource.TestCheckResourceAttrPair(
+						"pagerduty_event_orchestration_service.serviceA", "service", "pagerduty_service.foo", "id"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_event_orchestration_service.serviceA", "set.0.rule.0.actions.0.annotate", "Please use our P1 runbook"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_event_orchestration_service.serviceA", "set.0.rule.0.actions.0.suspend", "120"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_event_orchestration_service.serviceA", "set.0.rule.0.actions.0.automation_action.0.header.0.key", "X-Source"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_event_orchestration_service.serviceA", "catch_all.0.actions.0.suppress", "true"),
+				),
+			},
+			{
+				Config: testAccCheckPagerDutyEventOrchestrationServiceConfigRemoved(service, username, email, escalationPolicy),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyEventOrchestrationPathRuleCount("pagerduty_service.foo", pagerduty.PathTypeService, 0),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckPagerDutyEventOrchestrationServiceConfigRemoved(service, username, email, escalationPolicy string) string {
+	return fmt.Sprintf(`
+resource "pagerduty_user" "foo" {
+  name  = "%s"
+  email = "%s"
+}
+
+resource "pagerduty_escalation_policy" "foo" {
+  name = "%s"
+  rule {
+    escalation_delay_in_minutes = 10
+    target {
+      type = "user_reference"
+      id   = pagerduty_user.foo.id
+    }
+  }
+}
+
+resource "pagerduty_service" "foo" {
+  name              = "%s"
+  escalation_policy = pagerduty_escalation_policy.foo.id
+}
+`, username, email, escalationPolicy, service)
+}
+
+func testAccCheckPagerDutyEventOrchestrationServiceConfig(service, username, email, escalationPolicy string) string {
+	return testAccCheckPagerDutyEventOrchestrationServiceConfigRemoved(service, username, email, escalationPolicy) + `
+resource "pagerduty_event_orchestration_service" "serviceA" {
+  service = pagerduty_service.foo.id
+
+  set {
+    id = "start"
+    rule {
+      label = "annotate database events"
+      condition {
+        expression = "event.summary matches part 'database'"
+      }
+      actions {
+        annotate = "Please use our P1 runbook"
+        suspend  = 120
+        automation_action {
+          name = "notify"
+          url  = "https://example.com/notify"
+          header {
+            key   = "X-Source"
+            value = "pagerduty"
+          }
+        }
+      }
+    }
+  }
+
+  catch_all {
+    actions {
+      suppress = true
+    }
+  }
+}
+`
+}
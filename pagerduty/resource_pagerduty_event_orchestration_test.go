@@ -0,0 +1,147 @@
+package pagerduty
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func init() {
+	resource.AddTestSweepers("pagerduty_event_orchestration", &resource.Sweeper{
+		Name: "pagerduty_event_orchestration",
+		F:    testSweepEventOrchestration,
+	})
+}
+
+func testSweepEventOrchestration(region string) error {
+	config, err := sharedConfigForRegion(region)
+	if err != nil {
+		return err
+	}
+
+	client, err := config.Client()
+	if err != nil {
+		return err
+	}
+
+	resp, _, err := client.EventOrchestrations.List()
+	if err != nil {
+		return err
+	}
+
+	for _, orchestration := range resp.Orchestrations {
+		if strings.HasPrefix(orchestration.Name, "test") || strings.HasPrefix(orchestration.Name, "tf-") {
+			log.Printf("Destroying event orchestration %s (%s)", orchestration.Name, orchestration.ID)
+			if _, err := client.EventOrchestrations.Delete(orchestration.ID); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func TestAccPagerDutyEventOrchestration_Basic(t *testing.T) {
+	name := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	nameUpdated := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	team := fmt.Sprintf("tf-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPagerDutyEventOrchestrationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckPagerDutyEventOrchestrationConfig(name, team),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyEventOrchestrationExists("pagerduty_event_orchestration.foo"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_event_orchestration.foo", "name", name),
+					resource.TestCheckResourceAttr(
+						"pagerduty_event_orchestration.foo", "description", "foo"),
+					resource.TestCheckResourceAttrPair(
+						"pagerduty_event_orchestration.foo", "team", "pagerduty_team.foo", "id"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_event_orchestration.foo", "integration.#", "1"),
+					resource.TestCheckResourceAttrSet(
+						"pagerduty_event_orchestration.foo", "integration.0.parameters.0.routing_key"),
+				),
+			},
+			{
+				Config: testAccCheckPagerDutyEventOrchestrationConfigNoTeam(nameUpdated),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyEventOrchestrationExists("pagerduty_event_orchestration.foo"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_event_orchestration.foo", "name", nameUpdated),
+					resource.TestCheckResourceAttr(
+						"pagerduty_event_orchestration.foo", "description", ""),
+					resource.TestCheckResourceAttr(
+						"pagerduty_event_orchestration.foo", "team", ""),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckPagerDutyEventOrchestrationDestroy(s *terraform.State) error {
+	client, _ := testAccProvider.Meta().(*Config).Client()
+	for _, r := range s.RootModule().Resources {
+		if r.Type != "pagerduty_event_orchestration" {
+			continue
+		}
+		if _, _, err := client.EventOrchestrations.Get(r.Primary.ID); err == nil {
+			return fmt.Errorf("Event orchestration still exists")
+		}
+	}
+	return nil
+}
+
+func testAccCheckPagerDutyEventOrchestrationExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No event orchestration ID is set")
+		}
+
+		client, _ := testAccProvider.Meta().(*Config).Client()
+		found, _, err := client.EventOrchestrations.Get(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if found.ID != rs.Primary.ID {
+			return fmt.Errorf("Event orchestration not found: %v - %v", rs.Primary.ID, found)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckPagerDutyEventOrchestrationConfig(name, team string) string {
+	return fmt.Sprintf(`
+resource "pagerduty_team" "foo" {
+  name = "%s"
+}
+
+resource "pagerduty_event_orchestration" "foo" {
+  name        = "%s"
+  description = "foo"
+  team        = pagerduty_team.foo.id
+}
+`, team, name)
+}
+
+func testAccCheckPagerDutyEventOrchestrationConfigNoTeam(name string) string {
+	return fmt.Sprintf(`
+resource "pagerduty_event_orchestration" "foo" {
+  name = "%s"
+}
+`, name)
+}
@@ -0,0 +1,107 @@
+package pagerduty
+
+import (
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/heimweh/go-pagerduty/pagerduty"
+)
+
+func eventOrchestrationUnroutedRuleActionsSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"route_to": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"severity":     eventOrchestrationPathSeveritySchema(),
+		"event_action": eventOrchestrationPathEventActionSchema(),
+		"variable":     eventOrchestrationPathVariableSchema(),
+		"extraction":   eventOrchestrationPathExtractionSchema(),
+	}
+}
+
+func eventOrchestrationUnroutedCatchAllActionsSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"suppress": {
+			Type:     schema.TypeBool,
+			Computed: true,
+		},
+		"severity":     eventOrchestrationPathSeveritySchema(),
+		"event_action": eventOrchestrationPathEventActionSchema(),
+		"variable":     eventOrchestrationPathVariableSchema(),
+		"extraction":   eventOrchestrationPathExtractionSchema(),
+	}
+}
+
+func resourcePagerDutyEventOrchestrationUnrouted() *schema.Resource {
+	return &schema.Resource{
+		Create: resourcePagerDutyEventOrchestrationUnroutedUpdate,
+		Read:   resourcePagerDutyEventOrchestrationUnroutedRead,
+		Update: resourcePagerDutyEventOrchestrationUnroutedUpdate,
+		Delete: resourcePagerDutyEventOrchestrationUnroutedDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourcePagerDutyEventOrchestrationPathImport("event_orchestration"),
+		},
+		Schema: map[string]*schema.Schema{
+			"event_orchestration": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"set":       eventOrchestrationPathSetSchema(eventOrchestrationUnroutedRuleActionsSchema()),
+			"catch_all": eventOrchestrationPathCatchAllSchema(eventOrchestrationUnroutedCatchAllActionsSchema()),
+		},
+	}
+}
+
+func buildEventOrchestrationUnroutedStruct(d *schema.ResourceData) *pagerduty.EventOrchestrationPath {
+	catchAll := expandEventOrchestrationPathCatchAll(d.Get("catch_all"))
+	// suppress is read-only on the unrouted catch-all.
+	catchAll.Actions.Suppress = false
+
+	return &pagerduty.EventOrchestrationPath{
+		Type:     pagerduty.PathTypeUnrouted,
+		Sets:     expandEventOrchestrationPathSets(d.Get("set")),
+		CatchAll: catchAll,
+	}
+}
+
+func resourcePagerDutyEventOrchestrationUnroutedRead(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[INFO] Reading PagerDuty event orchestration unrouted rules: %s", d.Id())
+
+	d.Set("event_orchestration", d.Id())
+
+	return fetchEventOrchestrationPath(d, meta, pagerduty.PathTypeUnrouted, eventOrchestrationUnroutedRuleActionsSchema(), eventOrchestrationUnroutedCatchAllActionsSchema(), handleNotFoundError)
+}
+
+func resourcePagerDutyEventOrchestrationUnroutedUpdate(d *schema.ResourceData, meta interface{}) error {
+	client, _ := meta.(*Config).Client()
+
+	id := d.Get("event_orchestration").(string)
+
+	if err := updateEventOrchestrationPath(client, id, pagerduty.PathTypeUnrouted, buildEventOrchestrationUnroutedStruct(d)); err != nil {
+		return err
+	}
+
+	d.SetId(id)
+
+	return fetchEventOrchestrationPath(d, meta, pagerduty.PathTypeUnrouted, eventOrchestrationUnroutedRuleActionsSchema(), eventOrchestrationUnroutedCatchAllActionsSchema(), genError)
+}
+
+func resourcePagerDutyEventOrchestrationUnroutedDelete(d *schema.ResourceData, meta interface{}) error {
+	client, _ := meta.(*Config).Client()
+
+	empty := emptyEventOrchestrationPath(&pagerduty.EventOrchestrationPathRuleActions{})
+
+	if err := updateEventOrchestrationPath(client, d.Id(), pagerduty.PathTypeUnrouted, empty); err != nil {
+		if isErrCode(err, 404) {
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	d.SetId("")
+
+	return nil
+}
@@ -0,0 +1,97 @@
+package pagerduty
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/heimweh/go-pagerduty/pagerduty"
+)
+
+func TestAccPagerDutyEventOrchestrationUnrouted_Basic(t *testing.T) {
+	name := fmt.Sprintf("tf-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPagerDutyEventOrchestrationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckPagerDutyEventOrchestrationUnroutedConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyEventOrchestrationPathRuleCount("pagerduty_event_orchestration_unrouted.unrouted", pagerduty.PathTypeUnrouted, 2),
+					resource.TestCheckResourceAttr(
+						"pagerduty_event_orchestration_unrouted.unrouted", "set.#", "2"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_event_orchestration_unrouted.unrouted", "set.0.rule.0.actions.0.route_to", "child"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_event_orchestration_unrouted.unrouted", "set.1.rule.0.actions.0.severity", "critical"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_event_orchestration_unrouted.unrouted", "set.1.rule.0.actions.0.variable.0.name", "host"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_event_orchestration_unrouted.unrouted", "set.1.rule.0.actions.0.extraction.0.template", "{{variables.host}}"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_event_orchestration_unrouted.unrouted", "catch_all.0.actions.0.severity", "info"),
+				),
+			},
+			{
+				Config: testAccCheckPagerDutyEventOrchestrationConfigNoTeam(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyEventOrchestrationPathRuleCount("pagerduty_event_orchestration.foo", pagerduty.PathTypeUnrouted, 0),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckPagerDutyEventOrchestrationUnroutedConfig(name string) string {
+	return fmt.Sprintf(`
+resource "pagerduty_event_orchestration" "foo" {
+  name = "%s"
+}
+
+resource "pagerduty_event_orchestration_unrouted" "unrouted" {
+  event_orchestration = pagerduty_event_orchestration.foo.id
+
+  set {
+    id = "start"
+    rule {
+      label = "route to child"
+      condition {
+        expression = "event.source exists"
+      }
+      actions {
+        route_to = "child"
+      }
+    }
+  }
+
+  set {
+    id = "child"
+    rule {
+      label = "set severity"
+      actions {
+        severity = "critical"
+        variable {
+          name  = "host"
+          path  = "event.source"
+          type  = "regex"
+          value = "(.*)"
+        }
+        extraction {
+          target   = "event.summary"
+          template = "{{variables.host}}"
+        }
+      }
+    }
+  }
+
+  catch_all {
+    actions {
+      severity = "info"
+    }
+  }
+}
+`, name)
+}
@@ -1,12 +1,15 @@
 package pagerduty
 
 import (
+	"fmt"
 	"log"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
 	"github.com/heimweh/go-pagerduty/pagerduty"
+	"github.com/terraform-providers/terraform-provider-pagerduty/internal/eventrule"
 )
 
 func resourcePagerDutyEventRule() *schema.Resource {
@@ -20,16 +23,22 @@ func resourcePagerDutyEventRule() *schema.Resource {
 		},
 		Schema: map[string]*schema.Schema{
 			"action_json": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validateEventRuleActions,
+				DiffSuppressFunc: structure.SuppressJsonDiff,
 			},
 			"condition_json": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validateEventRuleCondition,
+				DiffSuppressFunc: structure.SuppressJsonDiff,
 			},
 			"advanced_condition_json": {
-				Type:     schema.TypeString,
-				Optional: true,
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validateEventRuleAdvancedCondition,
+				DiffSuppressFunc: structure.SuppressJsonDiff,
 			},
 			"catch_all": {
 				Type:     schema.TypeBool,
@@ -39,6 +48,27 @@ func resourcePagerDutyEventRule() *schema.Resource {
 	}
 }
 
+func validateEventRuleActions(v interface{}, k string) (we []string, errors []error) {
+	if _, err := eventrule.ParseActions(v.(string)); err != nil {
+		errors = append(errors, fmt.Errorf("%s is invalid: %v", k, err))
+	}
+	return
+}
+
+func validateEventRuleCondition(v interface{}, k string) (we []string, errors []error) {
+	if _, err := eventrule.ParseCondition(v.(string)); err != nil {
+		errors = append(errors, fmt.Errorf("%s is invalid: %v", k, err))
+	}
+	return
+}
+
+func validateEventRuleAdvancedCondition(v interface{}, k string) (we []string, errors []error) {
+	if _, _, err := eventrule.ParseAdvancedCondition(v.(string)); err != nil {
+		errors = append(errors, fmt.Errorf("%s is invalid: %v", k, err))
+	}
+	return
+}
+
 func buildEventRuleStruct(d *schema.ResourceData) *pagerduty.EventRule {
 	eventRule := &pagerduty.EventRule{
 		Actions:   expandString(d.Get("action_json").(string)),
@@ -0,0 +1,196 @@
+package pagerduty
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/heimweh/go-pagerduty/pagerduty"
+)
+
+var incidentCustomFieldDataTypes = []string{
+	"string",
+	"integer",
+	"float",
+	"boolean",
+	"url",
+	"datetime",
+}
+
+var incidentCustomFieldFieldTypes = []string{
+	"single_value",
+	"single_value_fixed",
+	"multi_value",
+	"multi_value_fixed",
+}
+
+func resourcePagerDutyIncidentCustomField() *schema.Resource {
+	return &schema.Resource{
+		Create: resourcePagerDutyIncidentCustomFieldCreate,
+		Read:   resourcePagerDutyIncidentCustomFieldRead,
+		Update: resourcePagerDutyIncidentCustomFieldUpdate,
+		Delete: resourcePagerDutyIncidentCustomFieldDelete,
+		CustomizeDiff: func(context context.Context, diff *schema.ResourceDiff, i interface{}) error {
+			return validateIncidentCustomFieldType(diff.Get("data_type").(string), diff.Get("field_type").(string))
+		},
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"display_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"data_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateValueFunc(incidentCustomFieldDataTypes),
+			},
+			"field_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateValueFunc(incidentCustomFieldFieldTypes),
+			},
+		},
+	}
+}
+
+// validateIncidentCustomFieldType checks that the field type of a custom field
+// is supported for its data type. Fixed options can only be defined for string,
+// integer and float fields, and boolean fields hold a single value.
+func validateIncidentCustomFieldType(dataType, fieldType string) error {
+	switch fieldType {
+	case "single_value_fixed", "multi_value_fixed":
+		if !isIncidentCustomFieldOptionDataType(dataType) {
+			return fmt.Errorf("field_type %s is not supported for %s fields, fixed options can only be defined for string, integer and float fields", fieldType, dataType)
+		}
+	case "multi_value":
+		if dataType == "boolean" {
+			return fmt.Errorf("field_type multi_value is not supported for boolean fields")
+		}
+	}
+
+	return nil
+}
+
+func buildIncidentCustomFieldStruct(d *schema.ResourceData) *pagerduty.IncidentCustomField {
+	description := d.Get("description").(string)
+
+	return &pagerduty.IncidentCustomField{
+		Name:        d.Get("name").(string),
+		DisplayName: d.Get("display_name").(string),
+		Description: &description,
+		DataType:    d.Get("data_type").(string),
+		FieldType:   d.Get("field_type").(string),
+	}
+}
+
+func fetchPagerDutyIncidentCustomField(d *schema.ResourceData, meta interface{}, errCallback func(error, *schema.ResourceData) error) error {
+	client, _ := meta.(*Config).Client()
+
+	return resource.Retry(2*time.Minute, func() *resource.RetryError {
+		field, _, err := client.IncidentCustomFields.Get(d.Id())
+		if err != nil {
+			errResp := errCallback(err, d)
+			if errResp != nil {
+				time.Sleep(2 * time.Second)
+				return resource.RetryableError(errResp)
+			}
+
+			return nil
+		}
+
+		d.Set("name", field.Name)
+		d.Set("display_name", field.DisplayName)
+		d.Set("data_type", field.DataType)
+		d.Set("field_type", field.FieldType)
+
+		if field.Description != nil {
+			d.Set("description", *field.Description)
+		} else {
+			d.Set("description", "")
+		}
+
+		return nil
+	})
+}
+
+func resourcePagerDutyIncidentCustomFieldCreate(d *schema.ResourceData, meta interface{}) error {
+	client, _ := meta.(*Config).Client()
+
+	field := buildIncidentCustomFieldStruct(d)
+
+	log.Printf("[INFO] Creating PagerDuty incident custom field: %s", field.Name)
+
+	retryErr := resource.Retry(10*time.Second, func() *resource.RetryError {
+		if field, _, err := client.IncidentCustomFields.Create(field); err != nil {
+			if isErrCode(err, 429) {
+				return resource.RetryableError(err)
+			}
+
+			return resource.NonRetryableError(err)
+		} else if field != nil {
+			d.SetId(field.ID)
+		}
+		return nil
+	})
+
+	if retryErr != nil {
+		return retryErr
+	}
+
+	return fetchPagerDutyIncidentCustomField(d, meta, genError)
+}
+
+func resourcePagerDutyIncidentCustomFieldRead(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[INFO] Reading PagerDuty incident custom field: %s", d.Id())
+	return fetchPagerDutyIncidentCustomField(d, meta, handleNotFoundError)
+}
+
+func resourcePagerDutyIncidentCustomFieldUpdate(d *schema.ResourceData, meta interface{}) error {
+	client, _ := meta.(*Config).Client()
+
+	description := d.Get("description").(string)
+
+	// Only the display name and the description of a field can be changed.
+	field := &pagerduty.IncidentCustomField{
+		DisplayName: d.Get("display_name").(string),
+		Description: &description,
+	}
+
+	log.Printf("[INFO] Updating PagerDuty incident custom field: %s", d.Id())
+
+	if _, _, err := client.IncidentCustomFields.Update(d.Id(), field); err != nil {
+		return err
+	}
+
+	return fetchPagerDutyIncidentCustomField(d, meta, genError)
+}
+
+func resourcePagerDutyIncidentCustomFieldDelete(d *schema.ResourceData, meta interface{}) error {
+	client, _ := meta.(*Config).Client()
+
+	log.Printf("[INFO] Deleting PagerDuty incident custom field: %s", d.Id())
+
+	if _, err := client.IncidentCustomFields.Delete(d.Id()); err != nil {
+		return err
+	}
+
+	d.SetId("")
+
+	return nil
+}
@@ -0,0 +1,232 @@
+package pagerduty
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/heimweh/go-pagerduty/pagerduty"
+)
+
+var incidentCustomFieldOptionDataTypes = []string{
+	"string",
+	"integer",
+	"float",
+}
+
+func resourcePagerDutyIncidentCustomFieldOption() *schema.Resource {
+	return &schema.Resource{
+		Create: resourcePagerDutyIncidentCustomFieldOptionCreate,
+		Read:   resourcePagerDutyIncidentCustomFieldOptionRead,
+		Update: resourcePagerDutyIncidentCustomFieldOptionUpdate,
+		Delete: resourcePagerDutyIncidentCustomFieldOptionDelete,
+		CustomizeDiff: func(context context.Context, diff *schema.ResourceDiff, i interface{}) error {
+			if !diff.NewValueKnown("value") {
+				return nil
+			}
+
+			_, err := convertIncidentCustomFieldOptionValue(diff.Get("data_type").(string), diff.Get("value").(string))
+			return err
+		},
+		Importer: &schema.ResourceImporter{
+			State: resourcePagerDutyIncidentCustomFieldOptionImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"field": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"data_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateValueFunc(incidentCustomFieldOptionDataTypes),
+			},
+			"value": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+}
+
+func isIncidentCustomFieldOptionDataType(dataType string) bool {
+	for _, t := range incidentCustomFieldOptionDataTypes {
+		if t == dataType {
+			return true
+		}
+	}
+	return false
+}
+
+// convertIncidentCustomFieldOptionValue converts the value of an option to the
+// JSON type the API expects for the data type of the option.
+func convertIncidentCustomFieldOptionValue(dataType, value string) (interface{}, error) {
+	switch dataType {
+	case "integer":
+		v, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("value %q of an integer option is not an integer", value)
+		}
+		return v, nil
+	case "float":
+		v, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return nil, fmt.Errorf("value %q of a float option is not a number", value)
+		}
+		return v, nil
+	}
+
+	return value, nil
+}
+
+// flattenIncidentCustomFieldOptionValue formats a value decoded from the API
+// the way it is written in the configuration.
+func flattenIncidentCustomFieldOptionValue(value interface{}) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case nil:
+		return ""
+	}
+
+	return fmt.Sprintf("%v", value)
+}
+
+func buildIncidentCustomFieldOptionStruct(d *schema.ResourceData) (*pagerduty.IncidentCustomFieldOption, error) {
+	dataType := d.Get("data_type").(string)
+
+	value, err := convertIncidentCustomFieldOptionValue(dataType, d.Get("value").(string))
+	if err != nil {
+		return nil, err
+	}
+
+	return &pagerduty.IncidentCustomFieldOption{
+		Type: "field_option",
+		Data: &pagerduty.IncidentCustomFieldOptionData{
+			DataType: dataType,
+			Value:    value,
+		},
+	}, nil
+}
+
+func fetchPagerDutyIncidentCustomFieldOption(d *schema.ResourceData, meta interface{}, errCallback func(error, *schema.ResourceData) error) error {
+	client, _ := meta.(*Config).Client()
+
+	return resource.Retry(2*time.Minute, func() *resource.RetryError {
+		option, _, err := client.IncidentCustomFields.GetOption(d.Get("field").(string), d.Id())
+		if err != nil {
+			errResp := errCallback(err, d)
+			if errResp != nil {
+				time.Sleep(2 * time.Second)
+				return resource.RetryableError(errResp)
+			}
+
+			return nil
+		}
+
+		if option.Data != nil {
+			d.Set("data_type", option.Data.DataType)
+			d.Set("value", flattenIncidentCustomFieldOptionValue(option.Data.Value))
+		}
+
+		return nil
+	})
+}
+
+func resourcePagerDutyIncidentCustomFieldOptionCreate(d *schema.ResourceData, meta interface{}) error {
+	client, _ := meta.(*Config).Client()
+
+	option, err := buildIncidentCustomFieldOptionStruct(d)
+	if err != nil {
+		return err
+	}
+
+	fieldID := d.Get("field").(string)
+
+	log.Printf("[INFO] Creating PagerDuty option of incident custom field: %s", fieldID)
+
+	retryErr := resource.Retry(10*time.Second, func() *resource.RetryError {
+		if option, _, err := client.IncidentCustomFields.CreateOption(fieldID, option); err != nil {
+			if isErrCode(err, 429) {
+				return resource.RetryableError(err)
+			}
+
+			return resource.NonRetryableError(err)
+		} else if option != nil {
+			d.SetId(option.ID)
+		}
+		return nil
+	})
+
+	if retryErr != nil {
+		return retryErr
+	}
+
+	return fetchPagerDutyIncidentCustomFieldOption(d, meta, genError)
+}
+
+func resourcePagerDutyIncidentCustomFieldOptionRead(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[INFO] Reading PagerDuty incident custom field option: %s", d.Id())
+	return fetchPagerDutyIncidentCustomFieldOption(d, meta, handleNotFoundError)
+}
+
+func resourcePagerDutyIncidentCustomFieldOptionUpdate(d *schema.ResourceData, meta interface{}) error {
+	client, _ := meta.(*Config).Client()
+
+	option, err := buildIncidentCustomFieldOptionStruct(d)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Updating PagerDuty incident custom field option: %s", d.Id())
+
+	if _, _, err := client.IncidentCustomFields.UpdateOption(d.Get("field").(string), d.Id(), option); err != nil {
+		return err
+	}
+
+	return fetchPagerDutyIncidentCustomFieldOption(d, meta, genError)
+}
+
+func resourcePagerDutyIncidentCustomFieldOptionDelete(d *schema.ResourceData, meta interface{}) error {
+	client, _ := meta.(*Config).Client()
+
+	log.Printf("[INFO] Deleting PagerDuty incident custom field option: %s", d.Id())
+
+	if _, err := client.IncidentCustomFields.DeleteOption(d.Get("field").(string), d.Id()); err != nil {
+		return err
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func resourcePagerDutyIncidentCustomFieldOptionImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	client, _ := meta.(*Config).Client()
+
+	ids := strings.Split(d.Id(), ".")
+
+	if len(ids) != 2 {
+		return []*schema.ResourceData{}, fmt.Errorf("Error importing pagerduty_incident_custom_field_option. Expecting an importation ID formed as '<field_id>.<field_option_id>'")
+	}
+	fieldID, optionID := ids[0], ids[1]
+
+	_, _, err := client.IncidentCustomFields.GetOption(fieldID, optionID)
+	if err != nil {
+		return []*schema.ResourceData{}, err
+	}
+
+	d.SetId(optionID)
+	d.Set("field", fieldID)
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,137 @@
+package pagerduty
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestConvertIncidentCustomFieldOptionValue(t *testing.T) {
+	cases := []struct {
+		dataType string
+		value    string
+		want     interface{}
+		wantErr  bool
+	}{
+		{"string", "high", "high", false},
+		{"integer", "42", int64(42), false},
+		{"integer", "4.2", nil, true},
+		{"float", "4.2", 4.2, false},
+		{"float", "high", nil, true},
+	}
+
+	for _, c := range cases {
+		got, err := convertIncidentCustomFieldOptionValue(c.dataType, c.value)
+		if (err != nil) != c.wantErr {
+			t.Errorf("%s %q: got error %v", c.dataType, c.value, err)
+			continue
+		}
+		if err == nil && got != c.want {
+			t.Errorf("%s %q: got %#v, want %#v", c.dataType, c.value, got, c.want)
+		}
+	}
+}
+
+func TestFlattenIncidentCustomFieldOptionValue(t *testing.T) {
+	cases := []struct {
+		value interface{}
+		want  string
+	}{
+		{"high", "high"},
+		{float64(42), "42"},
+		{4.2, "4.2"},
+		{nil, ""},
+	}
+
+	for _, c := range cases {
+		if got := flattenIncidentCustomFieldOptionValue(c.value); got != c.want {
+			t.Errorf("%#v: got %q, want %q", c.value, got, c.want)
+		}
+	}
+}
+
+func TestAccPagerDutyIncidentCustomFieldOption_Basic(t *testing.T) {
+	name := fmt.Sprintf("tf_%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPagerDutyIncidentCustomFieldOptionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckPagerDutyIncidentCustomFieldOptionConfig(name, "high"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyIncidentCustomFieldOptionExists("pagerduty_incident_custom_field_option.foo"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_incident_custom_field_option.foo", "data_type", "string"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_incident_custom_field_option.foo", "value", "high"),
+				),
+			},
+			{
+				Config: testAccCheckPagerDutyIncidentCustomFieldOptionConfig(name, "critical"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyIncidentCustomFieldOptionExists("pagerduty_incident_custom_field_option.foo"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_incident_custom_field_option.foo", "value", "critical"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckPagerDutyIncidentCustomFieldOptionDestroy(s *terraform.State) error {
+	client, _ := testAccProvider.Meta().(*Config).Client()
+	for _, r := range s.RootModule().Resources {
+		if r.Type != "pagerduty_incident_custom_field_option" {
+			continue
+		}
+		if _, _, err := client.IncidentCustomFields.GetOption(r.Primary.Attributes["field"], r.Primary.ID); err == nil {
+			return fmt.Errorf("Incident custom field option still exists")
+		}
+	}
+	return nil
+}
+
+func testAccCheckPagerDutyIncidentCustomFieldOptionExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No incident custom field option ID is set")
+		}
+
+		client, _ := testAccProvider.Meta().(*Config).Client()
+		found, _, err := client.IncidentCustomFields.GetOption(rs.Primary.Attributes["field"], rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if found.ID != rs.Primary.ID {
+			return fmt.Errorf("Incident custom field option not found: %v - %v", rs.Primary.ID, found)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckPagerDutyIncidentCustomFieldOptionConfig(name, value string) string {
+	return fmt.Sprintf(`
+resource "pagerduty_incident_custom_field" "foo" {
+  name         = "%s"
+  display_name = "Customer impact"
+  data_type    = "string"
+  field_type   = "single_value_fixed"
+}
+
+resource "pagerduty_incident_custom_field_option" "foo" {
+  field     = pagerduty_incident_custom_field.foo.id
+  data_type = "string"
+  value     = "%s"
+}
+`, name, value)
+}
@@ -0,0 +1,158 @@
+package pagerduty
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func init() {
+	resource.AddTestSweepers("pagerduty_incident_custom_field", &resource.Sweeper{
+		Name: "pagerduty_incident_custom_field",
+		F:    testSweepIncidentCustomField,
+	})
+}
+
+func testSweepIncidentCustomField(region string) error {
+	config, err := sharedConfigForRegion(region)
+	if err != nil {
+		return err
+	}
+
+	client, err := config.Client()
+	if err != nil {
+		return err
+	}
+
+	resp, _, err := client.IncidentCustomFields.List()
+	if err != nil {
+		return err
+	}
+
+	for _, field := range resp.Fields {
+		if strings.HasPrefix(field.Name, "test") || strings.HasPrefix(field.Name, "tf_") {
+			log.Printf("Destroying incident custom field %s (%s)", field.Name, field.ID)
+			if _, err := client.IncidentCustomFields.Delete(field.ID); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func TestValidateIncidentCustomFieldType(t *testing.T) {
+	cases := []struct {
+		dataType  string
+		fieldType string
+		wantErr   bool
+	}{
+		{"string", "single_value", false},
+		{"string", "multi_value_fixed", false},
+		{"integer", "single_value_fixed", false},
+		{"float", "multi_value", false},
+		{"boolean", "single_value", false},
+		{"boolean", "multi_value", true},
+		{"boolean", "single_value_fixed", true},
+		{"url", "multi_value_fixed", true},
+		{"datetime", "single_value_fixed", true},
+	}
+
+	for _, c := range cases {
+		err := validateIncidentCustomFieldType(c.dataType, c.fieldType)
+		if (err != nil) != c.wantErr {
+			t.Errorf("%s %s: got error %v", c.dataType, c.fieldType, err)
+		}
+	}
+}
+
+func TestAccPagerDutyIncidentCustomField_Basic(t *testing.T) {
+	name := fmt.Sprintf("tf_%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPagerDutyIncidentCustomFieldDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckPagerDutyIncidentCustomFieldConfig(name, "Customer impact", "foo"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyIncidentCustomFieldExists("pagerduty_incident_custom_field.foo"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_incident_custom_field.foo", "name", name),
+					resource.TestCheckResourceAttr(
+						"pagerduty_incident_custom_field.foo", "display_name", "Customer impact"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_incident_custom_field.foo", "description", "foo"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_incident_custom_field.foo", "data_type", "string"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_incident_custom_field.foo", "field_type", "single_value_fixed"),
+				),
+			},
+			{
+				Config: testAccCheckPagerDutyIncidentCustomFieldConfig(name, "Impact on customers", "bar"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyIncidentCustomFieldExists("pagerduty_incident_custom_field.foo"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_incident_custom_field.foo", "display_name", "Impact on customers"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_incident_custom_field.foo", "description", "bar"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckPagerDutyIncidentCustomFieldDestroy(s *terraform.State) error {
+	client, _ := testAccProvider.Meta().(*Config).Client()
+	for _, r := range s.RootModule().Resources {
+		if r.Type != "pagerduty_incident_custom_field" {
+			continue
+		}
+		if _, _, err := client.IncidentCustomFields.Get(r.Primary.ID); err == nil {
+			return fmt.Errorf("Incident custom field still exists")
+		}
+	}
+	return nil
+}
+
+func testAccCheckPagerDutyIncidentCustomFieldExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No incident custom field ID is set")
+		}
+
+		client, _ := testAccProvider.Meta().(*Config).Client()
+		found, _, err := client.IncidentCustomFields.Get(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if found.ID != rs.Primary.ID {
+			return fmt.Errorf("Incident custom field not found: %v - %v", rs.Primary.ID, found)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckPagerDutyIncidentCustomFieldConfig(name, displayName, description string) string {
+	return fmt.Sprintf(`
+resource "pagerduty_incident_custom_field" "foo" {
+  name         = "%s"
+  display_name = "%s"
+  description  = "%s"
+  data_type    = "string"
+  field_type   = "single_value_fixed"
+}
+`, name, displayName, description)
+}
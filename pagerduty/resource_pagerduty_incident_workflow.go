@@ -0,0 +1,317 @@
+package pagerduty
+
+import (
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/heimweh/go-pagerduty/pagerduty"
+)
+
+func resourcePagerDutyIncidentWorkflow() *schema.Resource {
+	return &schema.Resource{
+		Create: resourcePagerDutyIncidentWorkflowCreate,
+		Read:   resourcePagerDutyIncidentWorkflowRead,
+		Update: resourcePagerDutyIncidentWorkflowUpdate,
+		Delete: resourcePagerDutyIncidentWorkflowDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"team": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"step": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"action": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"input": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"value": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func buildIncidentWorkflowStruct(d *schema.ResourceData) *pagerduty.IncidentWorkflow {
+	description := d.Get("description").(string)
+
+	workflow := &pagerduty.IncidentWorkflow{
+		Type:        "incident_workflow",
+		Name:        d.Get("name").(string),
+		Description: &description,
+		Steps:       expandIncidentWorkflowSteps(d.Get("step").([]interface{})),
+	}
+
+	if attr, ok := d.GetOk("team"); ok {
+		workflow.Team = &pagerduty.TeamReference{
+			ID:   attr.(string),
+			Type: "team_reference",
+		}
+	}
+
+	return workflow
+}
+
+func expandIncidentWorkflowSteps(v []interface{}) []*pagerduty.IncidentWorkflowStep {
+	steps := make([]*pagerduty.IncidentWorkflowStep, 0, len(v))
+
+	for _, s := range v {
+		rs := s.(map[string]interface{})
+
+		configuration := &pagerduty.IncidentWorkflowActionConfiguration{
+			ActionID: rs["action"].(string),
+		}
+
+		for _, i := range rs["input"].([]interface{}) {
+			ri := i.(map[string]interface{})
+			configuration.Inputs = append(configuration.Inputs, &pagerduty.IncidentWorkflowActionInput{
+				Name:  ri["name"].(string),
+				Value: ri["value"].(string),
+			})
+		}
+
+		steps = append(steps, &pagerduty.IncidentWorkflowStep{
+			ID:            rs["id"].(string),
+			Type:          "step",
+			Name:          rs["name"].(string),
+			Configuration: configuration,
+		})
+	}
+
+	return steps
+}
+
+// flattenIncidentWorkflowSteps flattens the steps of a workflow. The API
+// returns every input of an action, including the ones left at their
+// default, so only the inputs already known for a step are kept. Steps are
+// matched to the known ones by ID, or by name for steps that have no ID yet.
+// All inputs are kept when no step is known, i.e. after an import.
+func flattenIncidentWorkflowSteps(d *schema.ResourceData, steps []*pagerduty.IncidentWorkflowStep) []interface{} {
+	known := knownIncidentWorkflowStepInputs(d.Get("step").([]interface{}), steps)
+
+	var flattened []interface{}
+
+	for i, s := range steps {
+		step := map[string]interface{}{
+			"id":   s.ID,
+			"name": s.Name,
+		}
+
+		var inputs []interface{}
+		if s.Configuration != nil {
+			step["action"] = s.Configuration.ActionID
+
+			for _, in := range s.Configuration.Inputs {
+				if known != nil && !known[i][in.Name] {
+					continue
+				}
+				inputs = append(inputs, map[string]interface{}{
+					"name":  in.Name,
+					"value": in.Value,
+				})
+			}
+		}
+		step["input"] = inputs
+
+		flattened = append(flattened, step)
+	}
+
+	return flattened
+}
+
+// knownIncidentWorkflowStepInputs returns the names of the known inputs of
+// each step, or nil if no step is known.
+func knownIncidentWorkflowStepInputs(old []interface{}, steps []*pagerduty.IncidentWorkflowStep) []map[string]bool {
+	if len(old) == 0 {
+		return nil
+	}
+
+	type knownStep struct {
+		id, name string
+		inputs   map[string]bool
+		matched  bool
+	}
+
+	var candidates []*knownStep
+	for _, s := range old {
+		rs, ok := s.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		k := &knownStep{
+			id:     rs["id"].(string),
+			name:   rs["name"].(string),
+			inputs: make(map[string]bool),
+		}
+		for _, in := range rs["input"].([]interface{}) {
+			if ri, ok := in.(map[string]interface{}); ok {
+				k.inputs[ri["name"].(string)] = true
+			}
+		}
+		candidates = append(candidates, k)
+	}
+
+	known := make([]map[string]bool, len(steps))
+
+	// IDs are matched first so that a step matched by name can't take the
+	// known step of another one.
+	for i, s := range steps {
+		for _, k := range candidates {
+			if !k.matched && k.id != "" && k.id == s.ID {
+				k.matched = true
+				known[i] = k.inputs
+				break
+			}
+		}
+	}
+	for i, s := range steps {
+		if known[i] != nil {
+			continue
+		}
+		for _, k := range candidates {
+			if !k.matched && k.name == s.Name {
+				k.matched = true
+				known[i] = k.inputs
+				break
+			}
+		}
+	}
+
+	return known
+}
+
+func fetchPagerDutyIncidentWorkflow(d *schema.ResourceData, meta interface{}, errCallback func(error, *schema.ResourceData) error) error {
+	client, _ := meta.(*Config).Client()
+
+	return resource.Retry(2*time.Minute, func() *resource.RetryError {
+		workflow, _, err := client.IncidentWorkflows.Get(d.Id())
+		if err != nil {
+			errResp := errCallback(err, d)
+			if errResp != nil {
+				time.Sleep(2 * time.Second)
+				return resource.RetryableError(errResp)
+			}
+
+			return nil
+		}
+
+		d.Set("name", workflow.Name)
+
+		if workflow.Description != nil {
+			d.Set("description", *workflow.Description)
+		} else {
+			d.Set("description", "")
+		}
+
+		if workflow.Team != nil {
+			d.Set("team", workflow.Team.ID)
+		} else {
+			d.Set("team", "")
+		}
+
+		if err := d.Set("step", flattenIncidentWorkflowSteps(d, workflow.Steps)); err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+}
+
+func resourcePagerDutyIncidentWorkflowCreate(d *schema.ResourceData, meta interface{}) error {
+	client, _ := meta.(*Config).Client()
+
+	workflow := buildIncidentWorkflowStruct(d)
+
+	log.Printf("[INFO] Creating PagerDuty incident workflow: %s", workflow.Name)
+
+	retryErr := resource.Retry(10*time.Second, func() *resource.RetryError {
+		if workflow, _, err := client.IncidentWorkflows.Create(workflow); err != nil {
+			if isErrCode(err, 429) {
+				return resource.RetryableError(err)
+			}
+
+			return resource.NonRetryableError(err)
+		} else if workflow != nil {
+			d.SetId(workflow.ID)
+		}
+		return nil
+	})
+
+	if retryErr != nil {
+		return retryErr
+	}
+
+	return fetchPagerDutyIncidentWorkflow(d, meta, genError)
+}
+
+func resourcePagerDutyIncidentWorkflowRead(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[INFO] Reading PagerDuty incident workflow: %s", d.Id())
+	return fetchPagerDutyIncidentWorkflow(d, meta, handleNotFoundError)
+}
+
+func resourcePagerDutyIncidentWorkflowUpdate(d *schema.ResourceData, meta interface{}) error {
+	client, _ := meta.(*Config).Client()
+
+	workflow := buildIncidentWorkflowStruct(d)
+
+	log.Printf("[INFO] Updating PagerDuty incident workflow: %s", d.Id())
+
+	if _, _, err := client.IncidentWorkflows.Update(d.Id(), workflow); err != nil {
+		return err
+	}
+
+	return fetchPagerDutyIncidentWorkflow(d, meta, genError)
+}
+
+func resourcePagerDutyIncidentWorkflowDelete(d *schema.ResourceData, meta interface{}) error {
+	client, _ := meta.(*Config).Client()
+
+	log.Printf("[INFO] Deleting PagerDuty incident workflow: %s", d.Id())
+
+	if _, err := client.IncidentWorkflows.Delete(d.Id()); err != nil {
+		return err
+	}
+
+	d.SetId("")
+
+	return nil
+}
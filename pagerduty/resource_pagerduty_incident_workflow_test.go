@@ -0,0 +1,257 @@
+package pagerduty
+
+import (
+	"fmt"
+	"log"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/heimweh/go-pagerduty/pagerduty"
+)
+
+func init() {
+	resource.AddTestSweepers("pagerduty_incident_workflow", &resource.Sweeper{
+		Name: "pagerduty_incident_workflow",
+		F:    testSweepIncidentWorkflow,
+	})
+}
+
+func testSweepIncidentWorkflow(region string) error {
+	config, err := sharedConfigForRegion(region)
+	if err != nil {
+		return err
+	}
+
+	client, err := config.Client()
+	if err != nil {
+		return err
+	}
+
+	resp, _, err := client.IncidentWorkflows.List()
+	if err != nil {
+		return err
+	}
+
+	for _, workflow := range resp.IncidentWorkflows {
+		if strings.HasPrefix(workflow.Name, "test") || strings.HasPrefix(workflow.Name, "tf-") {
+			log.Printf("Destroying incident workflow %s (%s)", workflow.Name, workflow.ID)
+			if _, err := client.IncidentWorkflows.Delete(workflow.ID); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func TestFlattenIncidentWorkflowSteps(t *testing.T) {
+	apiStep := func(id, name string) *pagerduty.IncidentWorkflowStep {
+		return &pagerduty.IncidentWorkflowStep{
+			ID:   id,
+			Name: name,
+			Configuration: &pagerduty.IncidentWorkflowActionConfiguration{
+				ActionID: "pagerduty.com:incident-workflows:send-status-update:1",
+				Inputs: []*pagerduty.IncidentWorkflowActionInput{
+					{Name: "Message", Value: "hello"},
+					{Name: "Channel", Value: "general"},
+					{Name: "Default", Value: "set by PagerDuty"},
+				},
+			},
+		}
+	}
+	knownStep := func(id, name string, inputs ...string) map[string]interface{} {
+		var in []interface{}
+		for _, name := range inputs {
+			in = append(in, map[string]interface{}{"name": name, "value": ""})
+		}
+		return map[string]interface{}{"id": id, "name": name, "action": "", "input": in}
+	}
+	inputNames := func(flattened []interface{}) [][]string {
+		var names [][]string
+		for _, s := range flattened {
+			var step []string
+			for _, in := range s.(map[string]interface{})["input"].([]interface{}) {
+				step = append(step, in.(map[string]interface{})["name"].(string))
+			}
+			names = append(names, step)
+		}
+		return names
+	}
+
+	cases := []struct {
+		name  string
+		known []interface{}
+		steps []*pagerduty.IncidentWorkflowStep
+		want  [][]string
+	}{
+		{
+			name:  "import",
+			steps: []*pagerduty.IncidentWorkflowStep{apiStep("S1", "Notify")},
+			want:  [][]string{{"Message", "Channel", "Default"}},
+		},
+		{
+			name: "reordered steps are matched by ID",
+			known: []interface{}{
+				knownStep("S1", "Notify", "Message"),
+				knownStep("S2", "Notify", "Channel"),
+			},
+			steps: []*pagerduty.IncidentWorkflowStep{apiStep("S2", "Notify"), apiStep("S1", "Notify")},
+			want:  [][]string{{"Channel"}, {"Message"}},
+		},
+		{
+			name: "new steps are matched by name",
+			known: []interface{}{
+				knownStep("", "First", "Message"),
+				knownStep("", "Second"),
+			},
+			steps: []*pagerduty.IncidentWorkflowStep{apiStep("S1", "Second"), apiStep("S2", "First")},
+			want:  [][]string{nil, {"Message"}},
+		},
+		{
+			name:  "unknown steps keep no default input",
+			known: []interface{}{knownStep("S1", "Notify", "Message")},
+			steps: []*pagerduty.IncidentWorkflowStep{apiStep("S1", "Notify"), apiStep("S9", "Added")},
+			want:  [][]string{{"Message"}, nil},
+		},
+	}
+
+	for _, c := range cases {
+		d := resourcePagerDutyIncidentWorkflow().TestResourceData()
+		if err := d.Set("step", c.known); err != nil {
+			t.Fatal(err)
+		}
+
+		if got := inputNames(flattenIncidentWorkflowSteps(d, c.steps)); !reflect.DeepEqual(got, c.want) {
+			t.Errorf("%s: expected inputs %v, got %v", c.name, c.want, got)
+		}
+	}
+}
+
+func TestAccPagerDutyIncidentWorkflow_Basic(t *testing.T) {
+	name := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	nameUpdated := fmt.Sprintf("tf-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPagerDutyIncidentWorkflowDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckPagerDutyIncidentWorkflowConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyIncidentWorkflowExists("pagerduty_incident_workflow.foo"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_incident_workflow.foo", "name", name),
+					resource.TestCheckResourceAttr(
+						"pagerduty_incident_workflow.foo", "description", "foo"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_incident_workflow.foo", "step.#", "1"),
+					resource.TestCheckResourceAttrSet(
+						"pagerduty_incident_workflow.foo", "step.0.id"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_incident_workflow.foo", "step.0.action", "pagerduty.com:incident-workflows:send-status-update:1"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_incident_workflow.foo", "step.0.input.#", "1"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_incident_workflow.foo", "step.0.input.0.value", "Investigating"),
+				),
+			},
+			{
+				Config: testAccCheckPagerDutyIncidentWorkflowConfigUpdated(nameUpdated),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyIncidentWorkflowExists("pagerduty_incident_workflow.foo"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_incident_workflow.foo", "name", nameUpdated),
+					resource.TestCheckResourceAttr(
+						"pagerduty_incident_workflow.foo", "description", ""),
+					resource.TestCheckResourceAttr(
+						"pagerduty_incident_workflow.foo", "step.#", "2"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_incident_workflow.foo", "step.0.name", "Add responders"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_incident_workflow.foo", "step.1.input.0.value", "Identified"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckPagerDutyIncidentWorkflowDestroy(s *terraform.State) error {
+	client, _ := testAccProvider.Meta().(*Config).Client()
+	for _, r := range s.RootModule().Resources {
+		if r.Type != "pagerduty_incident_workflow" {
+			continue
+		}
+		if _, _, err := client.IncidentWorkflows.Get(r.Primary.ID); err == nil {
+			return fmt.Errorf("Incident workflow still exists")
+		}
+	}
+	return nil
+}
+
+func testAccCheckPagerDutyIncidentWorkflowExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No incident workflow ID is set")
+		}
+
+		client, _ := testAccProvider.Meta().(*Config).Client()
+		found, _, err := client.IncidentWorkflows.Get(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if found.ID != rs.Primary.ID {
+			return fmt.Errorf("Incident workflow not found: %v - %v", rs.Primary.ID, found)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckPagerDutyIncidentWorkflowConfig(name string) string {
+	return fmt.Sprintf(`
+resource "pagerduty_incident_workflow" "foo" {
+  name        = "%s"
+  description = "foo"
+
+  step {
+    name   = "Send status update"
+    action = "pagerduty.com:incident-workflows:send-status-update:1"
+    input {
+      name  = "Message"
+      value = "Investigating"
+    }
+  }
+}
+`, name)
+}
+
+func testAccCheckPagerDutyIncidentWorkflowConfigUpdated(name string) string {
+	return fmt.Sprintf(`
+resource "pagerduty_incident_workflow" "foo" {
+  name = "%s"
+
+  step {
+    name   = "Add responders"
+    action = "pagerduty.com:incident-workflows:add-responders:1"
+  }
+
+  step {
+    name   = "Send status update"
+    action = "pagerduty.com:incident-workflows:send-status-update:1"
+    input {
+      name  = "Message"
+      value = "Identified"
+    }
+  }
+}
+`, name)
+}
@@ -0,0 +1,195 @@
+package pagerduty
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/heimweh/go-pagerduty/pagerduty"
+)
+
+func resourcePagerDutyIncidentWorkflowTrigger() *schema.Resource {
+	return &schema.Resource{
+		Create: resourcePagerDutyIncidentWorkflowTriggerCreate,
+		Read:   resourcePagerDutyIncidentWorkflowTriggerRead,
+		Update: resourcePagerDutyIncidentWorkflowTriggerUpdate,
+		Delete: resourcePagerDutyIncidentWorkflowTriggerDelete,
+		CustomizeDiff: func(context context.Context, diff *schema.ResourceDiff, i interface{}) error {
+			t := diff.Get("type").(string)
+			condition := diff.Get("condition").(string)
+
+			if t == "conditional" && condition == "" && diff.NewValueKnown("condition") {
+				return fmt.Errorf("condition must be set for a conditional incident workflow trigger")
+			}
+			if t == "manual" && condition != "" {
+				return fmt.Errorf("condition cannot be set for a manual incident workflow trigger")
+			}
+			return nil
+		},
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Schema: map[string]*schema.Schema{
+			"type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validateValueFunc([]string{
+					"manual",
+					"conditional",
+				}),
+			},
+			"workflow": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"services": {
+				Type:          schema.TypeSet,
+				Optional:      true,
+				Elem:          &schema.Schema{Type: schema.TypeString},
+				ConflictsWith: []string{"subscribed_to_all_services"},
+			},
+			"subscribed_to_all_services": {
+				Type:          schema.TypeBool,
+				Optional:      true,
+				ConflictsWith: []string{"services"},
+			},
+			"condition": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func buildIncidentWorkflowTriggerStruct(d *schema.ResourceData) *pagerduty.IncidentWorkflowTrigger {
+	trigger := &pagerduty.IncidentWorkflowTrigger{
+		Type:        "incident_workflow_trigger",
+		TriggerType: d.Get("type").(string),
+		Workflow: &pagerduty.IncidentWorkflow{
+			ID:   d.Get("workflow").(string),
+			Type: "incident_workflow_reference",
+		},
+		Services:                []*pagerduty.ServiceReference{},
+		SubscribedToAllServices: d.Get("subscribed_to_all_services").(bool),
+	}
+
+	for _, s := range d.Get("services").(*schema.Set).List() {
+		trigger.Services = append(trigger.Services, &pagerduty.ServiceReference{
+			ID:   s.(string),
+			Type: "service_reference",
+		})
+	}
+
+	if attr, ok := d.GetOk("condition"); ok {
+		condition := attr.(string)
+		trigger.Condition = &condition
+	}
+
+	return trigger
+}
+
+func fetchPagerDutyIncidentWorkflowTrigger(d *schema.ResourceData, meta interface{}, errCallback func(error, *schema.ResourceData) error) error {
+	client, _ := meta.(*Config).Client()
+
+	return resource.Retry(2*time.Minute, func() *resource.RetryError {
+		trigger, _, err := client.IncidentWorkflows.GetTrigger(d.Id())
+		if err != nil {
+			errResp := errCallback(err, d)
+			if errResp != nil {
+				time.Sleep(2 * time.Second)
+				return resource.RetryableError(errResp)
+			}
+
+			return nil
+		}
+
+		d.Set("type", trigger.TriggerType)
+		d.Set("subscribed_to_all_services", trigger.SubscribedToAllServices)
+
+		if trigger.Workflow != nil {
+			d.Set("workflow", trigger.Workflow.ID)
+		}
+
+		if trigger.Condition != nil {
+			d.Set("condition", *trigger.Condition)
+		} else {
+			d.Set("condition", "")
+		}
+
+		var services []string
+		// Triggers subscribed to all services list every service.
+		if !trigger.SubscribedToAllServices {
+			for _, s := range trigger.Services {
+				services = append(services, s.ID)
+			}
+		}
+		d.Set("services", services)
+
+		return nil
+	})
+}
+
+func resourcePagerDutyIncidentWorkflowTriggerCreate(d *schema.ResourceData, meta interface{}) error {
+	client, _ := meta.(*Config).Client()
+
+	trigger := buildIncidentWorkflowTriggerStruct(d)
+
+	log.Printf("[INFO] Creating PagerDuty %s trigger of incident workflow: %s", trigger.TriggerType, trigger.Workflow.ID)
+
+	retryErr := resource.Retry(10*time.Second, func() *resource.RetryError {
+		if trigger, _, err := client.IncidentWorkflows.CreateTrigger(trigger); err != nil {
+			if isErrCode(err, 429) {
+				return resource.RetryableError(err)
+			}
+
+			return resource.NonRetryableError(err)
+		} else if trigger != nil {
+			d.SetId(trigger.ID)
+		}
+		return nil
+	})
+
+	if retryErr != nil {
+		return retryErr
+	}
+
+	return fetchPagerDutyIncidentWorkflowTrigger(d, meta, genError)
+}
+
+func resourcePagerDutyIncidentWorkflowTriggerRead(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[INFO] Reading PagerDuty incident workflow trigger: %s", d.Id())
+	return fetchPagerDutyIncidentWorkflowTrigger(d, meta, handleNotFoundError)
+}
+
+func resourcePagerDutyIncidentWorkflowTriggerUpdate(d *schema.ResourceData, meta interface{}) error {
+	client, _ := meta.(*Config).Client()
+
+	trigger := buildIncidentWorkflowTriggerStruct(d)
+
+	log.Printf("[INFO] Updating PagerDuty incident workflow trigger: %s", d.Id())
+
+	if _, _, err := client.IncidentWorkflows.UpdateTrigger(d.Id(), trigger); err != nil {
+		return err
+	}
+
+	return fetchPagerDutyIncidentWorkflowTrigger(d, meta, genError)
+}
+
+func resourcePagerDutyIncidentWorkflowTriggerDelete(d *schema.ResourceData, meta interface{}) error {
+	client, _ := meta.(*Config).Client()
+
+	log.Printf("[INFO] Deleting PagerDuty incident workflow trigger: %s", d.Id())
+
+	if _, err := client.IncidentWorkflows.DeleteTrigger(d.Id()); err != nil {
+		return err
+	}
+
+	d.SetId("")
+
+	return nil
+}
@@ -0,0 +1,163 @@
+package pagerduty
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccPagerDutyIncidentWorkflowTrigger_Basic(t *testing.T) {
+	workflow := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	service := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	username := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	email := fmt.Sprintf("%s@foo.com", username)
+	escalationPolicy := fmt.Sprintf("tf-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPagerDutyIncidentWorkflowTriggerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckPagerDutyIncidentWorkflowTriggerConfig(workflow, service, username, email, escalationPolicy),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyIncidentWorkflowTriggerExists("pagerduty_incident_workflow_trigger.manual"),
+					testAccCheckPagerDutyIncidentWorkflowTriggerExists("pagerduty_incident_workflow_trigger.conditional"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_incident_workflow_trigger.manual", "type", "manual"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_incident_workflow_trigger.manual", "services.#", "1"),
+					resource.TestCheckResourceAttrPair(
+						"pagerduty_incident_workflow_trigger.manual", "workflow", "pagerduty_incident_workflow.foo", "id"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_incident_workflow_trigger.conditional", "condition", "incident.priority matches 'P1'"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_incident_workflow_trigger.conditional", "subscribed_to_all_services", "true"),
+				),
+			},
+			{
+				Config: testAccCheckPagerDutyIncidentWorkflowTriggerConfigUpdated(workflow, service, username, email, escalationPolicy),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"pagerduty_incident_workflow_trigger.manual", "services.#", "0"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_incident_workflow_trigger.conditional", "condition", "incident.priority matches 'P2'"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_incident_workflow_trigger.conditional", "subscribed_to_all_services", "false"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_incident_workflow_trigger.conditional", "services.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckPagerDutyIncidentWorkflowTriggerDestroy(s *terraform.State) error {
+	client, _ := testAccProvider.Meta().(*Config).Client()
+	for _, r := range s.RootModule().Resources {
+		if r.Type != "pagerduty_incident_workflow_trigger" {
+			continue
+		}
+		if _, _, err := client.IncidentWorkflows.GetTrigger(r.Primary.ID); err == nil {
+			return fmt.Errorf("Incident workflow trigger still exists")
+		}
+	}
+	return nil
+}
+
+func testAccCheckPagerDutyIncidentWorkflowTriggerExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No incident workflow trigger ID is set")
+		}
+
+		client, _ := testAccProvider.Meta().(*Config).Client()
+		found, _, err := client.IncidentWorkflows.GetTrigger(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if found.ID != rs.Primary.ID {
+			return fmt.Errorf("Incident workflow trigger not found: %v - %v", rs.Primary.ID, found)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckPagerDutyIncidentWorkflowTriggerDependencies(workflow, service, username, email, escalationPolicy string) string {
+	return fmt.Sprintf(`
+resource "pagerduty_user" "foo" {
+  name  = "%s"
+  email = "%s"
+}
+
+resource "pagerduty_escalation_policy" "foo" {
+  name = "%s"
+  rule {
+    escalation_delay_in_minutes = 10
+    target {
+      type = "user_reference"
+      id   = pagerduty_user.foo.id
+    }
+  }
+}
+
+resource "pagerduty_service" "foo" {
+  name              = "%s"
+  escalation_policy = pagerduty_escalation_policy.foo.id
+}
+
+resource "pagerduty_incident_workflow" "foo" {
+  name = "%s"
+
+  step {
+    name   = "Send status update"
+    action = "pagerduty.com:incident-workflows:send-status-update:1"
+    input {
+      name  = "Message"
+      value = "Investigating"
+    }
+  }
+}
+`, username, email, escalationPolicy, service, workflow)
+}
+
+func testAccCheckPagerDutyIncidentWorkflowTriggerConfig(workflow, service, username, email, escalationPolicy string) string {
+	return testAccCheckPagerDutyIncidentWorkflowTriggerDependencies(workflow, service, username, email, escalationPolicy) + `
+resource "pagerduty_incident_workflow_trigger" "manual" {
+  type     = "manual"
+  workflow = pagerduty_incident_workflow.foo.id
+  services = [pagerduty_service.foo.id]
+}
+
+resource "pagerduty_incident_workflow_trigger" "conditional" {
+  type                       = "conditional"
+  workflow                   = pagerduty_incident_workflow.foo.id
+  condition                  = "incident.priority matches 'P1'"
+  subscribed_to_all_services = true
+}
+`
+}
+
+func testAccCheckPagerDutyIncidentWorkflowTriggerConfigUpdated(workflow, service, username, email, escalationPolicy string) string {
+	return testAccCheckPagerDutyIncidentWorkflowTriggerDependencies(workflow, service, username, email, escalationPolicy) + `
+resource "pagerduty_incident_workflow_trigger" "manual" {
+  type     = "manual"
+  workflow = pagerduty_incident_workflow.foo.id
+}
+
+resource "pagerduty_incident_workflow_trigger" "conditional" {
+  type      = "conditional"
+  workflow  = pagerduty_incident_workflow.foo.id
+  condition = "incident.priority matches 'P2'"
+  services  = [pagerduty_service.foo.id]
+}
+`
+}
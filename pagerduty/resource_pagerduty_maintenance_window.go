@@ -1,6 +1,7 @@
 package pagerduty
 
 import (
+	"fmt"
 	"log"
 	"time"
 
@@ -20,15 +21,17 @@ func resourcePagerDutyMaintenanceWindow() *schema.Resource {
 		},
 		Schema: map[string]*schema.Schema{
 			"start_time": {
-				Type:             schema.TypeString,
-				Required:         true,
-				ValidateFunc:     validateRFC3339,
-				DiffSuppressFunc: suppressRFC3339Diff,
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateRFC3339,
+				// PagerDuty starts windows created with a start_time in the
+				// past right away, like schedule layers.
+				DiffSuppressFunc: suppressScheduleLayerStartDiff,
 			},
 			"end_time": {
 				Type:             schema.TypeString,
 				Required:         true,
-				ValidateFunc:     validateRFC3339,
+				ValidateFunc:     validateMaintenanceWindowEndTime,
 				DiffSuppressFunc: suppressRFC3339Diff,
 			},
 
@@ -44,10 +47,51 @@ func resourcePagerDutyMaintenanceWindow() *schema.Resource {
 				Optional: true,
 				Default:  "Managed by Terraform",
 			},
+
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 		},
 	}
 }
 
+// validateMaintenanceWindowEndTime warns about windows which already ended.
+// PagerDuty rejects them and they are removed from the state once read.
+func validateMaintenanceWindowEndTime(v interface{}, k string) (we []string, errors []error) {
+	if we, errors = validateRFC3339(v, k); len(errors) > 0 {
+		return
+	}
+
+	if end, _ := time.Parse(time.RFC3339, v.(string)); !end.After(time.Now()) {
+		we = append(we, fmt.Sprintf("%s %s is in the past. PagerDuty doesn't create maintenance windows which already ended and ended windows are removed from the state, remove this window from the configuration.", k, v))
+	}
+
+	return
+}
+
+// maintenanceWindowStatus returns whether a maintenance window is future,
+// ongoing or past.
+func maintenanceWindowStatus(startTime, endTime string, now time.Time) (string, error) {
+	start, err := time.Parse(time.RFC3339, startTime)
+	if err != nil {
+		return "", err
+	}
+	end, err := time.Parse(time.RFC3339, endTime)
+	if err != nil {
+		return "", err
+	}
+
+	switch {
+	case now.Before(start):
+		return "future", nil
+	case now.Before(end):
+		return "ongoing", nil
+	default:
+		return "past", nil
+	}
+}
+
 func buildMaintenanceWindowStruct(d *schema.ResourceData) *pagerduty.MaintenanceWindow {
 	window := &pagerduty.MaintenanceWindow{
 		StartTime: d.Get("start_time").(string),
@@ -96,9 +140,21 @@ func resourcePagerDutyMaintenanceWindowRead(d *schema.ResourceData, meta interfa
 			return nil
 		}
 
+		status, err := maintenanceWindowStatus(window.StartTime, window.EndTime, time.Now())
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		if status == "past" {
+			log.Printf("[WARN] Removing maintenance window %s because it ended at %s", d.Id(), window.EndTime)
+			d.SetId("")
+			return nil
+		}
+
 		d.Set("description", window.Description)
 		d.Set("start_time", window.StartTime)
 		d.Set("end_time", window.EndTime)
+		d.Set("status", status)
 
 		if err := d.Set("services", flattenServices(window.Services)); err != nil {
 			return resource.NonRetryableError(err)
@@ -125,12 +181,33 @@ func resourcePagerDutyMaintenanceWindowUpdate(d *schema.ResourceData, meta inter
 func resourcePagerDutyMaintenanceWindowDelete(d *schema.ResourceData, meta interface{}) error {
 	client, _ := meta.(*Config).Client()
 
-	log.Printf("[INFO] Deleting PagerDuty maintenance window %s", d.Id())
-
-	if _, err := client.MaintenanceWindows.Delete(d.Id()); err != nil {
+	now := time.Now()
+	status, err := maintenanceWindowStatus(d.Get("start_time").(string), d.Get("end_time").(string), now)
+	if err != nil {
 		return err
 	}
 
+	switch status {
+	case "past":
+		log.Printf("[INFO] Removing PagerDuty maintenance window %s which already ended", d.Id())
+	case "ongoing":
+		// Windows in progress can't be deleted, they are ended instead.
+		log.Printf("[INFO] Ending PagerDuty maintenance window %s", d.Id())
+
+		window := buildMaintenanceWindowStruct(d)
+		window.EndTime = now.Format(time.RFC3339)
+
+		if _, _, err := client.MaintenanceWindows.Update(d.Id(), window); err != nil && !isErrCode(err, 404) {
+			return err
+		}
+	default:
+		log.Printf("[INFO] Deleting PagerDuty maintenance window %s", d.Id())
+
+		if _, err := client.MaintenanceWindows.Delete(d.Id()); err != nil && !isErrCode(err, 404) {
+			return err
+		}
+	}
+
 	d.SetId("")
 
 	return nil
@@ -3,10 +3,13 @@ package pagerduty
 import (
 	"fmt"
 	"log"
+	"net/http"
+	"net/http/httptest"
 	"strings"
 	"testing"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -58,6 +61,8 @@ func TestAccPagerDutyMaintenanceWindow_Basic(t *testing.T) {
 				Config: testAccCheckPagerDutyMaintenanceWindowConfig(window, windowStartTime, windowEndTime),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckPagerDutyMaintenanceWindowExists("pagerduty_maintenance_window.foo"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_maintenance_window.foo", "status", "future"),
 				),
 			},
 			{
@@ -70,6 +75,125 @@ func TestAccPagerDutyMaintenanceWindow_Basic(t *testing.T) {
 	})
 }
 
+func TestAccPagerDutyMaintenanceWindow_Ongoing(t *testing.T) {
+	window := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	windowStartTime := timeNowInAccLoc().Add(-time.Hour).Format(time.RFC3339)
+	windowEndTime := timeNowInAccLoc().Add(24 * time.Hour).Format(time.RFC3339)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPagerDutyMaintenanceWindowEnded,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckPagerDutyMaintenanceWindowConfig(window, windowStartTime, windowEndTime),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyMaintenanceWindowExists("pagerduty_maintenance_window.foo"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_maintenance_window.foo", "status", "ongoing"),
+				),
+			},
+		},
+	})
+}
+
+func TestPagerDutyMaintenanceWindow_Status(t *testing.T) {
+	now := time.Date(2024, 3, 20, 12, 0, 0, 0, time.UTC)
+
+	cases := map[string]string{
+		"future":  "2024-03-20T15:00:00+02:00",
+		"ongoing": "2024-03-20T13:00:00+02:00",
+		"past":    "2024-03-20T11:00:00+02:00",
+	}
+
+	for want, start := range cases {
+		end := "2024-03-20T14:00:00Z"
+		if want == "past" {
+			end = "2024-03-20T12:00:00Z"
+		}
+
+		got, err := maintenanceWindowStatus(start, end, now)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != want {
+			t.Errorf("expected window from %s to %s to be %s at %s, got %s", start, end, want, now, got)
+		}
+	}
+}
+
+func TestPagerDutyMaintenanceWindow_EndTimeInThePast(t *testing.T) {
+	config := func(end string) *terraform.ResourceConfig {
+		return terraform.NewResourceConfigRaw(map[string]interface{}{
+			"start_time": time.Now().Add(-2 * time.Hour).Format(time.RFC3339),
+			"end_time":   end,
+			"services":   []interface{}{"PSVC123"},
+		})
+	}
+
+	diags := resourcePagerDutyMaintenanceWindow().Validate(config(time.Now().Add(-time.Hour).Format(time.RFC3339)))
+	if diags.HasError() || len(diags) != 1 || diags[0].Severity != diag.Warning || !strings.Contains(diags[0].Summary, "is in the past") {
+		t.Errorf("expected a warning about a window in the past, got %#v", diags)
+	}
+
+	if diags := resourcePagerDutyMaintenanceWindow().Validate(config(time.Now().Add(time.Hour).Format(time.RFC3339))); len(diags) > 0 {
+		t.Errorf("expected an ongoing window to be valid, got %#v", diags)
+	}
+
+	if diags := resourcePagerDutyMaintenanceWindow().Validate(config("tomorrow")); !diags.HasError() {
+		t.Errorf("expected an invalid time to be rejected, got %#v", diags)
+	}
+}
+
+func TestPagerDutyMaintenanceWindow_ReadPast(t *testing.T) {
+	end := time.Now().Add(-time.Hour)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/maintenance_windows/PMW1234" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		fmt.Fprintf(w, `{"maintenance_window":{"id":"PMW1234","start_time":%q,"end_time":%q,"services":[{"id":"PSVC123","type":"service_reference"}]}}`,
+			end.Add(-time.Hour).Format(time.RFC3339), end.Format(time.RFC3339))
+	}))
+	defer srv.Close()
+
+	d := resourcePagerDutyMaintenanceWindow().TestResourceData()
+	d.SetId("PMW1234")
+	if err := resourcePagerDutyMaintenanceWindowRead(d, &Config{Token: "foo", ApiUrlOverride: srv.URL, SkipCredsValidation: true}); err != nil {
+		t.Fatal(err)
+	}
+	if d.Id() != "" {
+		t.Errorf("expected a window which ended to be removed from the state")
+	}
+}
+
+// testAccCheckPagerDutyMaintenanceWindowEnded checks that destroying an
+// ongoing window ended it rather than deleting it.
+func testAccCheckPagerDutyMaintenanceWindowEnded(s *terraform.State) error {
+	client, _ := testAccProvider.Meta().(*Config).Client()
+	for _, r := range s.RootModule().Resources {
+		if r.Type != "pagerduty_maintenance_window" {
+			continue
+		}
+
+		window, _, err := client.MaintenanceWindows.Get(r.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		status, err := maintenanceWindowStatus(window.StartTime, window.EndTime, time.Now())
+		if err != nil {
+			return err
+		}
+		if status != "past" {
+			return fmt.Errorf("Maintenance window %s is still %s", r.Primary.ID, status)
+		}
+	}
+	return nil
+}
+
 func testAccCheckPagerDutyMaintenanceWindowDestroy(s *terraform.State) error {
 	client, _ := testAccProvider.Meta().(*Config).Client()
 	for _, r := range s.RootModule().Resources {
@@ -0,0 +1,77 @@
+package pagerduty
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/heimweh/go-pagerduty/pagerduty"
+)
+
+var msteamsConnectionPlatform = &chatConnectionPlatform{
+	resourceName: "pagerduty_msteams_connection",
+	displayName:  "Microsoft Teams connection",
+	containerKey: "team_id",
+
+	create: func(client *pagerduty.Client, teamID string, c *chatConnection) (*chatConnection, error) {
+		teamsConn, _, err := client.MSTeamsConnections.Create(teamID, expandMSTeamsConnection(teamID, c))
+		if err != nil || teamsConn == nil {
+			return nil, err
+		}
+		return flattenMSTeamsConnection(teamsConn), nil
+	},
+	get: func(client *pagerduty.Client, teamID, id string) (*chatConnection, error) {
+		teamsConn, _, err := client.MSTeamsConnections.Get(teamID, id)
+		if err != nil || teamsConn == nil {
+			return nil, err
+		}
+		return flattenMSTeamsConnection(teamsConn), nil
+	},
+	update: func(client *pagerduty.Client, teamID, id string, c *chatConnection) error {
+		_, _, err := client.MSTeamsConnections.Update(teamID, id, expandMSTeamsConnection(teamID, c))
+		return err
+	},
+	delete: func(client *pagerduty.Client, teamID, id string) error {
+		_, err := client.MSTeamsConnections.Delete(teamID, id)
+		return err
+	},
+	list: func(client *pagerduty.Client, teamID string) ([]*chatConnection, error) {
+		resp, _, err := client.MSTeamsConnections.List(teamID)
+		if err != nil {
+			return nil, err
+		}
+
+		var connections []*chatConnection
+		for _, teamsConn := range resp.MSTeamsConnections {
+			connections = append(connections, flattenMSTeamsConnection(teamsConn))
+		}
+		return connections, nil
+	},
+}
+
+func resourcePagerDutyMSTeamsConnection() *schema.Resource {
+	return resourcePagerDutyChatConnection(msteamsConnectionPlatform)
+}
+
+func expandMSTeamsConnection(teamID string, c *chatConnection) *pagerduty.MSTeamsConnection {
+	return &pagerduty.MSTeamsConnection{
+		SourceID:         c.SourceID,
+		SourceName:       c.SourceName,
+		SourceType:       c.SourceType,
+		ChannelID:        c.ChannelID,
+		ChannelName:      c.ChannelName,
+		TeamID:           teamID,
+		NotificationType: c.NotificationType,
+		Config:           c.Config,
+	}
+}
+
+func flattenMSTeamsConnection(teamsConn *pagerduty.MSTeamsConnection) *chatConnection {
+	return &chatConnection{
+		ID:               teamsConn.ID,
+		SourceID:         teamsConn.SourceID,
+		SourceName:       teamsConn.SourceName,
+		SourceType:       teamsConn.SourceType,
+		ChannelID:        teamsConn.ChannelID,
+		ChannelName:      teamsConn.ChannelName,
+		NotificationType: teamsConn.NotificationType,
+		Config:           teamsConn.Config,
+	}
+}
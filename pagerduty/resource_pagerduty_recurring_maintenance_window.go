@@ -0,0 +1,393 @@
+package pagerduty
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/heimweh/go-pagerduty/pagerduty"
+	"github.com/terraform-providers/terraform-provider-pagerduty/internal/recurrence"
+)
+
+// resourcePagerDutyRecurringMaintenanceWindow manages the maintenance windows
+// of the next `horizon` occurrences of a recurrence. Every apply reconciles
+// the scheduled windows: missing ones are created and the ones which no
+// longer match the recurrence are deleted. Windows which already started are
+// never changed.
+func resourcePagerDutyRecurringMaintenanceWindow() *schema.Resource {
+	return &schema.Resource{
+		Create: resourcePagerDutyRecurringMaintenanceWindowCreate,
+		Read:   resourcePagerDutyRecurringMaintenanceWindowRead,
+		Update: resourcePagerDutyRecurringMaintenanceWindowUpdate,
+		Delete: resourcePagerDutyRecurringMaintenanceWindowDelete,
+		CustomizeDiff: func(context context.Context, diff *schema.ResourceDiff, i interface{}) error {
+			for _, k := range []string{"recurrence", "time_zone", "start_time", "duration_in_minutes", "horizon"} {
+				if !diff.NewValueKnown(k) {
+					if diff.Id() == "" {
+						return nil
+					}
+					return diff.SetNewComputed("windows")
+				}
+			}
+
+			schedule, err := buildRecurringMaintenanceWindowSchedule(diff.Get("recurrence").(string), diff.Get("time_zone").(string), diff.Get("start_time").(string))
+			if err != nil {
+				return err
+			}
+
+			if diff.Id() == "" {
+				return nil
+			}
+
+			for _, k := range []string{"recurrence", "time_zone", "start_time", "duration_in_minutes", "horizon", "services", "description"} {
+				if diff.HasChange(k) {
+					return diff.SetNewComputed("windows")
+				}
+			}
+
+			// Occurrences pass between applies, so the scheduled windows
+			// drift from the recurrence even when the configuration doesn't.
+			duration := time.Duration(diff.Get("duration_in_minutes").(int)) * time.Minute
+			desired := schedule.Occurrences(time.Now(), diff.Get("horizon").(int))
+
+			var scheduled []time.Time
+			for _, w := range expandRecurringMaintenanceWindows(diff.Get("windows").([]interface{})) {
+				if w.start.After(time.Now()) {
+					if !w.end.Equal(w.start.Add(duration)) {
+						return diff.SetNewComputed("windows")
+					}
+					scheduled = append(scheduled, w.start)
+				}
+			}
+
+			if len(scheduled) != len(desired) {
+				return diff.SetNewComputed("windows")
+			}
+			for i := range desired {
+				if !scheduled[i].Equal(desired[i]) {
+					return diff.SetNewComputed("windows")
+				}
+			}
+
+			return nil
+		},
+		Schema: map[string]*schema.Schema{
+			"recurrence": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"time_zone": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "UTC",
+				ValidateFunc: validateTimeZone,
+			},
+			"start_time": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validateRFC3339,
+				DiffSuppressFunc: suppressRFC3339Diff,
+			},
+			"duration_in_minutes": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"horizon": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      8,
+				ValidateFunc: validation.IntBetween(1, 52),
+			},
+			"services": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "Managed by Terraform",
+			},
+			"windows": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"start_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"end_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func buildRecurringMaintenanceWindowSchedule(expr, timeZone, startTime string) (*recurrence.Schedule, error) {
+	loc, err := time.LoadLocation(timeZone)
+	if err != nil {
+		return nil, err
+	}
+
+	var start time.Time
+	if startTime != "" {
+		if start, err = time.Parse(time.RFC3339, startTime); err != nil {
+			return nil, err
+		}
+	}
+
+	schedule, err := recurrence.Parse(expr, loc, start)
+	if err != nil {
+		return nil, fmt.Errorf("invalid recurrence %q: %v", expr, err)
+	}
+
+	return schedule, nil
+}
+
+type recurringMaintenanceWindow struct {
+	id    string
+	start time.Time
+	end   time.Time
+}
+
+func expandRecurringMaintenanceWindows(v []interface{}) []*recurringMaintenanceWindow {
+	var windows []*recurringMaintenanceWindow
+
+	for _, w := range v {
+		window := w.(map[string]interface{})
+		start, _ := time.Parse(time.RFC3339, window["start_time"].(string))
+		end, _ := time.Parse(time.RFC3339, window["end_time"].(string))
+
+		windows = append(windows, &recurringMaintenanceWindow{
+			id:    window["id"].(string),
+			start: start,
+			end:   end,
+		})
+	}
+
+	return windows
+}
+
+func flattenRecurringMaintenanceWindows(windows []*recurringMaintenanceWindow, loc *time.Location) []map[string]interface{} {
+	sort.Slice(windows, func(i, j int) bool { return windows[i].start.Before(windows[j].start) })
+
+	var flattened []map[string]interface{}
+	for _, w := range windows {
+		flattened = append(flattened, map[string]interface{}{
+			"id":         w.id,
+			"start_time": w.start.In(loc).Format(time.RFC3339),
+			"end_time":   w.end.In(loc).Format(time.RFC3339),
+		})
+	}
+
+	return flattened
+}
+
+func buildRecurringMaintenanceWindowStruct(d *schema.ResourceData, start, end time.Time) *pagerduty.MaintenanceWindow {
+	return &pagerduty.MaintenanceWindow{
+		StartTime:   start.Format(time.RFC3339),
+		EndTime:     end.Format(time.RFC3339),
+		Services:    expandServices(d.Get("services").(*schema.Set)),
+		Description: d.Get("description").(string),
+	}
+}
+
+// reconcileRecurringMaintenanceWindows creates the windows of the upcoming
+// occurrences which aren't scheduled yet and deletes the scheduled windows
+// which don't match an occurrence anymore.
+func reconcileRecurringMaintenanceWindows(d *schema.ResourceData, meta interface{}, current []*recurringMaintenanceWindow) error {
+	client, _ := meta.(*Config).Client()
+
+	schedule, err := buildRecurringMaintenanceWindowSchedule(d.Get("recurrence").(string), d.Get("time_zone").(string), d.Get("start_time").(string))
+	if err != nil {
+		return err
+	}
+	loc, _ := time.LoadLocation(d.Get("time_zone").(string))
+
+	now := time.Now()
+	duration := time.Duration(d.Get("duration_in_minutes").(int)) * time.Minute
+	desired := schedule.Occurrences(now, d.Get("horizon").(int))
+	scheduled := make([]bool, len(desired))
+	updateScheduled := d.HasChanges("services", "description")
+
+	var windows []*recurringMaintenanceWindow
+	var errs []error
+
+	for _, w := range current {
+		if !w.start.After(now) {
+			// Windows in progress are left alone, ended ones are forgotten.
+			if w.end.After(now) {
+				windows = append(windows, w)
+			}
+			continue
+		}
+
+		match := -1
+		for i, start := range desired {
+			if !scheduled[i] && w.start.Equal(start) && w.end.Equal(start.Add(duration)) {
+				match = i
+				break
+			}
+		}
+
+		if match < 0 {
+			log.Printf("[INFO] Deleting PagerDuty maintenance window %s of recurring maintenance window %s", w.id, d.Id())
+
+			if _, err := client.MaintenanceWindows.Delete(w.id); err != nil && !isErrCode(err, 404) {
+				errs = append(errs, err)
+				windows = append(windows, w)
+			}
+			continue
+		}
+
+		scheduled[match] = true
+		windows = append(windows, w)
+
+		if updateScheduled {
+			log.Printf("[INFO] Updating PagerDuty maintenance window %s of recurring maintenance window %s", w.id, d.Id())
+
+			if _, _, err := client.MaintenanceWindows.Update(w.id, buildRecurringMaintenanceWindowStruct(d, w.start, w.end)); err != nil {
+				errs = append(errs, err)
+			}
+		}
+	}
+
+	for i, start := range desired {
+		if scheduled[i] {
+			continue
+		}
+
+		log.Printf("[INFO] Creating PagerDuty maintenance window starting at %s for recurring maintenance window %s", start.Format(time.RFC3339), d.Id())
+
+		window, _, err := client.MaintenanceWindows.Create(buildRecurringMaintenanceWindowStruct(d, start, start.Add(duration)))
+		if err != nil {
+			errs = append(errs, err)
+			break
+		}
+
+		windows = append(windows, &recurringMaintenanceWindow{id: window.ID, start: start, end: start.Add(duration)})
+	}
+
+	// The windows which were created are kept even when others failed, so
+	// that they are cleaned up by the next apply.
+	if err := d.Set("windows", flattenRecurringMaintenanceWindows(windows, loc)); err != nil {
+		return err
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("failed to reconcile the maintenance windows of recurring maintenance window %s: %v", d.Id(), errs)
+	}
+
+	return nil
+}
+
+func resourcePagerDutyRecurringMaintenanceWindowCreate(d *schema.ResourceData, meta interface{}) error {
+	d.SetId(resource.UniqueId())
+
+	log.Printf("[INFO] Creating PagerDuty recurring maintenance window %s", d.Id())
+
+	return reconcileRecurringMaintenanceWindows(d, meta, nil)
+}
+
+func resourcePagerDutyRecurringMaintenanceWindowRead(d *schema.ResourceData, meta interface{}) error {
+	client, _ := meta.(*Config).Client()
+
+	log.Printf("[INFO] Reading PagerDuty recurring maintenance window %s", d.Id())
+
+	loc, err := time.LoadLocation(d.Get("time_zone").(string))
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	var windows []*recurringMaintenanceWindow
+
+	for _, w := range expandRecurringMaintenanceWindows(d.Get("windows").([]interface{})) {
+		if !w.end.After(now) {
+			continue
+		}
+
+		retryErr := resource.Retry(2*time.Minute, func() *resource.RetryError {
+			window, _, err := client.MaintenanceWindows.Get(w.id)
+			if err != nil {
+				if isErrCode(err, 404) {
+					log.Printf("[WARN] Maintenance window %s of recurring maintenance window %s is gone", w.id, d.Id())
+					return nil
+				}
+
+				time.Sleep(2 * time.Second)
+				return resource.RetryableError(err)
+			}
+
+			// Windows changed outside of Terraform no longer match an
+			// occurrence and are replaced by the next apply.
+			start, err := time.Parse(time.RFC3339, window.StartTime)
+			if err != nil {
+				return resource.NonRetryableError(err)
+			}
+			end, err := time.Parse(time.RFC3339, window.EndTime)
+			if err != nil {
+				return resource.NonRetryableError(err)
+			}
+
+			windows = append(windows, &recurringMaintenanceWindow{id: window.ID, start: start, end: end})
+
+			return nil
+		})
+
+		if retryErr != nil {
+			return retryErr
+		}
+	}
+
+	return d.Set("windows", flattenRecurringMaintenanceWindows(windows, loc))
+}
+
+func resourcePagerDutyRecurringMaintenanceWindowUpdate(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[INFO] Updating PagerDuty recurring maintenance window %s", d.Id())
+
+	// The planned windows are unknown, the scheduled ones are in the state.
+	current, _ := d.GetChange("windows")
+
+	return reconcileRecurringMaintenanceWindows(d, meta, expandRecurringMaintenanceWindows(current.([]interface{})))
+}
+
+func resourcePagerDutyRecurringMaintenanceWindowDelete(d *schema.ResourceData, meta interface{}) error {
+	client, _ := meta.(*Config).Client()
+
+	log.Printf("[INFO] Deleting PagerDuty recurring maintenance window %s", d.Id())
+
+	now := time.Now()
+
+	for _, w := range expandRecurringMaintenanceWindows(d.Get("windows").([]interface{})) {
+		// Deleting a window in progress would end it.
+		if !w.start.After(now) {
+			log.Printf("[INFO] Keeping PagerDuty maintenance window %s which is in progress", w.id)
+			continue
+		}
+
+		if _, err := client.MaintenanceWindows.Delete(w.id); err != nil && !isErrCode(err, 404) {
+			return err
+		}
+	}
+
+	d.SetId("")
+
+	return nil
+}
@@ -0,0 +1,217 @@
+package pagerduty
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/heimweh/go-pagerduty/pagerduty"
+)
+
+func TestPagerDutyRecurringMaintenanceWindow_Reconcile(t *testing.T) {
+	var created []*pagerduty.MaintenanceWindow
+	var updated, deleted []string
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == "POST" && r.URL.Path == "/maintenance_windows":
+			var v pagerduty.MaintenanceWindowPayload
+			if err := json.NewDecoder(r.Body).Decode(&v); err != nil {
+				t.Fatal(err)
+			}
+			v.MaintenanceWindow.ID = fmt.Sprintf("PNEW%d", len(created))
+			created = append(created, v.MaintenanceWindow)
+			w.WriteHeader(http.StatusCreated)
+			json.NewEncoder(w).Encode(v)
+		case r.Method == "PUT":
+			updated = append(updated, r.URL.Path)
+			fmt.Fprint(w, `{"maintenance_window":{}}`)
+		case r.Method == "DELETE":
+			deleted = append(deleted, r.URL.Path)
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	}))
+	defer srv.Close()
+
+	d := schema.TestResourceDataRaw(t, resourcePagerDutyRecurringMaintenanceWindow().Schema, map[string]interface{}{
+		"recurrence":          "FREQ=DAILY;BYHOUR=2;BYMINUTE=0",
+		"duration_in_minutes": 60,
+		"horizon":             3,
+		"services":            []interface{}{"PSERVICE"},
+	})
+	d.SetId("recurring")
+
+	schedule, err := buildRecurringMaintenanceWindowSchedule("FREQ=DAILY;BYHOUR=2;BYMINUTE=0", "UTC", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	now := time.Now()
+	desired := schedule.Occurrences(now, 3)
+
+	current := []*recurringMaintenanceWindow{
+		{id: "PINPROGRESS", start: now.Add(-10 * time.Minute), end: now.Add(50 * time.Minute)},
+		{id: "PENDED", start: now.Add(-2 * time.Hour), end: now.Add(-time.Hour)},
+		{id: "PKEEP", start: desired[0], end: desired[0].Add(time.Hour)},
+		{id: "PSTALE", start: desired[0].Add(30 * time.Minute), end: desired[0].Add(90 * time.Minute)},
+	}
+
+	config := &Config{Token: "foo", ApiUrlOverride: srv.URL, SkipCredsValidation: true}
+	if err := reconcileRecurringMaintenanceWindows(d, config, current); err != nil {
+		t.Fatal(err)
+	}
+
+	// The services of the resource data are new, so the scheduled windows are
+	// updated. The window in progress is left alone.
+	if len(updated) != 1 || updated[0] != "/maintenance_windows/PKEEP" {
+		t.Errorf("expected only the scheduled window to be updated, got %v", updated)
+	}
+	if len(deleted) != 1 || deleted[0] != "/maintenance_windows/PSTALE" {
+		t.Errorf("expected only the stale window to be deleted, got %v", deleted)
+	}
+
+	if len(created) != 2 {
+		t.Fatalf("expected 2 windows to be created, got %d", len(created))
+	}
+	for i, w := range created {
+		if w.StartTime != desired[i+1].Format(time.RFC3339) || w.EndTime != desired[i+1].Add(time.Hour).Format(time.RFC3339) {
+			t.Errorf("unexpected window %s - %s, expected it to start at %s", w.StartTime, w.EndTime, desired[i+1])
+		}
+		if len(w.Services) != 1 || w.Services[0].ID != "PSERVICE" {
+			t.Errorf("unexpected services %v", w.Services)
+		}
+	}
+
+	var ids []string
+	for _, w := range d.Get("windows").([]interface{}) {
+		ids = append(ids, w.(map[string]interface{})["id"].(string))
+	}
+	if fmt.Sprint(ids) != "[PINPROGRESS PKEEP PNEW0 PNEW1]" {
+		t.Errorf("unexpected windows %v", ids)
+	}
+}
+
+func TestAccPagerDutyRecurringMaintenanceWindow_Basic(t *testing.T) {
+	username := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	email := fmt.Sprintf("%s@foo.com", username)
+	escalationPolicy := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	service := fmt.Sprintf("tf-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPagerDutyRecurringMaintenanceWindowDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckPagerDutyRecurringMaintenanceWindowConfig(username, email, escalationPolicy, service, "FREQ=WEEKLY", 4),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("FREQ=WEEKLY needs a BYDAY"),
+			},
+			{
+				Config: testAccCheckPagerDutyRecurringMaintenanceWindowConfig(username, email, escalationPolicy, service, "FREQ=WEEKLY;BYDAY=SU;BYHOUR=2;BYMINUTE=0", 4),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyRecurringMaintenanceWindowExists("pagerduty_recurring_maintenance_window.foo"),
+					resource.TestCheckResourceAttr("pagerduty_recurring_maintenance_window.foo", "windows.#", "4"),
+				),
+			},
+			{
+				Config: testAccCheckPagerDutyRecurringMaintenanceWindowConfig(username, email, escalationPolicy, service, "0 3 * * SAT", 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyRecurringMaintenanceWindowExists("pagerduty_recurring_maintenance_window.foo"),
+					resource.TestCheckResourceAttr("pagerduty_recurring_maintenance_window.foo", "windows.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckPagerDutyRecurringMaintenanceWindowDestroy(s *terraform.State) error {
+	client, _ := testAccProvider.Meta().(*Config).Client()
+	for _, r := range s.RootModule().Resources {
+		if r.Type != "pagerduty_recurring_maintenance_window" {
+			continue
+		}
+
+		for k, id := range r.Primary.Attributes {
+			if !regexp.MustCompile(`^windows\.\d+\.id$`).MatchString(k) {
+				continue
+			}
+			if _, _, err := client.MaintenanceWindows.Get(id); err == nil {
+				return fmt.Errorf("Maintenance window %s still exists", id)
+			}
+		}
+	}
+	return nil
+}
+
+func testAccCheckPagerDutyRecurringMaintenanceWindowExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No recurring maintenance window ID is set")
+		}
+
+		client, _ := testAccProvider.Meta().(*Config).Client()
+
+		for k, id := range rs.Primary.Attributes {
+			if !regexp.MustCompile(`^windows\.\d+\.id$`).MatchString(k) {
+				continue
+			}
+			if _, _, err := client.MaintenanceWindows.Get(id); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckPagerDutyRecurringMaintenanceWindowConfig(username, email, escalationPolicy, service, recurrence string, horizon int) string {
+	return fmt.Sprintf(`
+resource "pagerduty_user" "foo" {
+  name  = "%s"
+  email = "%s"
+}
+
+resource "pagerduty_escalation_policy" "foo" {
+  name      = "%s"
+  num_loops = 1
+
+  rule {
+    escalation_delay_in_minutes = 10
+
+    target {
+      type = "user_reference"
+      id   = pagerduty_user.foo.id
+    }
+  }
+}
+
+resource "pagerduty_service" "foo" {
+  name              = "%s"
+  escalation_policy = pagerduty_escalation_policy.foo.id
+}
+
+resource "pagerduty_recurring_maintenance_window" "foo" {
+  recurrence          = "%s"
+  time_zone           = "Europe/Paris"
+  duration_in_minutes = 120
+  horizon             = %d
+  services            = [pagerduty_service.foo.id]
+}
+`, username, email, escalationPolicy, service, recurrence, horizon)
+}
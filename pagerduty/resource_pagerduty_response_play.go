@@ -1,9 +1,11 @@
 package pagerduty
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -13,10 +15,11 @@ import (
 
 func resourcePagerDutyResponsePlay() *schema.Resource {
 	return &schema.Resource{
-		Create: resourcePagerDutyResponsePlayCreate,
-		Read:   resourcePagerDutyResponsePlayRead,
-		Update: resourcePagerDutyResponsePlayUpdate,
-		Delete: resourcePagerDutyResponsePlayDelete,
+		Create:        resourcePagerDutyResponsePlayCreate,
+		Read:          resourcePagerDutyResponsePlayRead,
+		Update:        resourcePagerDutyResponsePlayUpdate,
+		Delete:        resourcePagerDutyResponsePlayDelete,
+		CustomizeDiff: resourcePagerDutyResponsePlayCustomizeDiff,
 		Importer: &schema.ResourceImporter{
 			State: resourcePagerDutyResponsePlayImport,
 		},
@@ -37,7 +40,7 @@ func resourcePagerDutyResponsePlay() *schema.Resource {
 			},
 			"from": {
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
 			},
 			"team": {
 				Type:     schema.TypeString,
@@ -53,8 +56,9 @@ func resourcePagerDutyResponsePlay() *schema.Resource {
 							Optional: true,
 						},
 						"type": {
-							Type:     schema.TypeString,
-							Optional: true,
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validateValueFunc(responsePlaySubscriberTypes),
 						},
 					},
 				},
@@ -73,8 +77,9 @@ func resourcePagerDutyResponsePlay() *schema.Resource {
 							Optional: true,
 						},
 						"type": {
-							Type:     schema.TypeString,
-							Optional: true,
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validateValueFunc(responsePlayResponderTypes),
 						},
 						"name": {
 							Type:     schema.TypeString,
@@ -159,6 +164,13 @@ func resourcePagerDutyResponsePlay() *schema.Resource {
 					},
 				},
 			},
+			"responder_names": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
 			"responders_message": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -166,6 +178,11 @@ func resourcePagerDutyResponsePlay() *schema.Resource {
 			"runnability": {
 				Type:     schema.TypeString,
 				Optional: true,
+				ValidateFunc: validateValueFunc([]string{
+					"services",
+					"teams",
+					"responders",
+				}),
 			},
 			"conference_number": {
 				Type:     schema.TypeString,
@@ -179,6 +196,216 @@ func resourcePagerDutyResponsePlay() *schema.Resource {
 	}
 }
 
+var (
+	responsePlaySubscriberTypes = []string{"user_reference", "team_reference"}
+	// PagerDuty accepts the types of responders with or without the
+	// _reference suffix, and returns them without it.
+	responsePlayResponderTypes = []string{"user", "user_reference", "escalation_policy", "escalation_policy_reference"}
+)
+
+// responsePlayReferenceNames holds the names of the users, teams and
+// escalation policies referenced by pagerduty_response_play resources. The
+// objects of a kind are listed once, the first time one of them is looked
+// up, rather than read one by one.
+type responsePlayReferenceNames struct {
+	mu    sync.Mutex
+	names map[string]map[string]string
+}
+
+func newResponsePlayReferenceNames() *responsePlayReferenceNames {
+	return &responsePlayReferenceNames{names: make(map[string]map[string]string)}
+}
+
+// lookup returns the name of an object. Objects missing from the listing of
+// their kind, such as the ones created since, are read on their own.
+func (r *responsePlayReferenceNames) lookup(client *pagerduty.Client, kind, id string) (string, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	names, ok := r.names[kind]
+	if !ok {
+		var err error
+		if names, err = listResponsePlayReferenceNames(client, kind); err != nil {
+			return "", err
+		}
+		r.names[kind] = names
+	}
+
+	if name, ok := names[id]; ok {
+		return name, nil
+	}
+
+	name, err := getResponsePlayReferenceName(client, kind, id)
+	if err != nil {
+		return "", err
+	}
+	names[id] = name
+	return name, nil
+}
+
+func listResponsePlayReferenceNames(client *pagerduty.Client, kind string) (map[string]string, error) {
+	names := make(map[string]string)
+
+	switch kind {
+	case "user":
+		users, err := client.Users.ListAll(&pagerduty.ListUsersOptions{})
+		if err != nil {
+			return nil, err
+		}
+		for _, u := range users {
+			names[u.ID] = u.Name
+		}
+	case "team":
+		teams, err := client.Teams.ListAll(&pagerduty.ListTeamsOptions{})
+		if err != nil {
+			return nil, err
+		}
+		for _, t := range teams {
+			names[t.ID] = t.Name
+		}
+	case "escalation_policy":
+		eps, err := client.EscalationPolicies.ListAll(&pagerduty.ListEscalationPoliciesOptions{})
+		if err != nil {
+			return nil, err
+		}
+		for _, ep := range eps {
+			names[ep.ID] = ep.Name
+		}
+	}
+
+	return names, nil
+}
+
+func getResponsePlayReferenceName(client *pagerduty.Client, kind, id string) (string, error) {
+	switch kind {
+	case "user":
+		// The user is served from the cache when it's enabled.
+		user, _, err := client.Users.Get(id, &pagerduty.GetUserOptions{})
+		if err != nil {
+			return "", err
+		}
+		return user.Name, nil
+	case "team":
+		team, _, err := client.Teams.Get(id)
+		if err != nil {
+			return "", err
+		}
+		return team.Name, nil
+	default:
+		ep, _, err := client.EscalationPolicies.Get(id, &pagerduty.GetEscalationPolicyOptions{})
+		if err != nil {
+			return "", err
+		}
+		return ep.Name, nil
+	}
+}
+
+// responsePlayReference is a subscriber or responder of a response play.
+type responsePlayReference struct {
+	attr string
+	Type string
+	ID   string
+}
+
+// kind returns the type of the referenced object.
+func (ref *responsePlayReference) kind() string {
+	return strings.TrimSuffix(ref.Type, "_reference")
+}
+
+func (ref *responsePlayReference) describe() string {
+	return fmt.Sprintf("%s references the %s %s", ref.attr, strings.ReplaceAll(ref.kind(), "_", " "), ref.ID)
+}
+
+// lookupResponsePlayReferences returns the names of the references, failing
+// when one of them doesn't exist.
+func lookupResponsePlayReferences(config *Config, client *pagerduty.Client, refs []*responsePlayReference) ([]string, error) {
+	registry := config.responsePlayReferenceNames
+	if registry == nil {
+		registry = newResponsePlayReferenceNames()
+	}
+
+	var names []string
+	for _, ref := range refs {
+		switch ref.kind() {
+		case "user", "team", "escalation_policy":
+		default:
+			return nil, fmt.Errorf("%s, which is not a supported type", ref.describe())
+		}
+
+		name, err := registry.lookup(client, ref.kind(), ref.ID)
+		if err != nil {
+			if isErrCode(err, 404) {
+				return nil, fmt.Errorf("%s, which doesn't exist", ref.describe())
+			}
+			return nil, fmt.Errorf("%s, which couldn't be read: %s", ref.describe(), err)
+		}
+		names = append(names, name)
+	}
+
+	return names, nil
+}
+
+// plannedResponsePlayReferences returns the subscribers or responders whose
+// ID and type are known, and whether all of them are.
+func plannedResponsePlayReferences(diff *schema.ResourceDiff, attr string) ([]*responsePlayReference, bool) {
+	var refs []*responsePlayReference
+	known := true
+
+	for i, v := range diff.Get(attr).([]interface{}) {
+		key := fmt.Sprintf("%s.%d", attr, i)
+		if !diff.NewValueKnown(key+".id") || !diff.NewValueKnown(key+".type") {
+			known = false
+			continue
+		}
+		m, ok := v.(map[string]interface{})
+		if !ok || m["id"].(string) == "" {
+			continue
+		}
+		refs = append(refs, &responsePlayReference{attr: key, Type: m["type"].(string), ID: m["id"].(string)})
+	}
+
+	return refs, known
+}
+
+func resourcePagerDutyResponsePlayCustomizeDiff(context context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	config := meta.(*Config)
+
+	if diff.NewValueKnown("from") && diff.Get("from").(string) == "" && config.DefaultFromEmail == "" {
+		return fmt.Errorf("from must be set when the provider doesn't set default_from_email")
+	}
+
+	if diff.Get("runnability").(string) == "teams" && diff.NewValueKnown("team") && diff.Get("team").(string) == "" {
+		return fmt.Errorf("runnability can only be teams when team is set")
+	}
+
+	subscribers, _ := plannedResponsePlayReferences(diff, "subscriber")
+	responders, respondersKnown := plannedResponsePlayReferences(diff, "responder")
+	if len(subscribers) == 0 && len(responders) == 0 {
+		if respondersKnown {
+			return diff.SetNew("responder_names", []string{})
+		}
+		return diff.SetNewComputed("responder_names")
+	}
+
+	client, err := config.Client()
+	if err != nil {
+		return err
+	}
+
+	if _, err := lookupResponsePlayReferences(config, client, subscribers); err != nil {
+		return err
+	}
+	names, err := lookupResponsePlayReferences(config, client, responders)
+	if err != nil {
+		return err
+	}
+
+	if !respondersKnown {
+		return diff.SetNewComputed("responder_names")
+	}
+	return diff.SetNew("responder_names", names)
+}
+
 func buildResponsePlayStruct(d *schema.ResourceData) *pagerduty.ResponsePlay {
 	responsePlay := &pagerduty.ResponsePlay{
 		Name:      d.Get("name").(string),
@@ -238,7 +465,6 @@ func resourcePagerDutyResponsePlayCreate(d *schema.ResourceData, meta interface{
 			return resource.RetryableError(err)
 		} else if responsePlay != nil {
 			d.SetId(responsePlay.ID)
-			d.Set("from", responsePlay.FromEmail)
 			log.Printf("[INFO] Created PagerDuty response play: %s (from: %s)", d.Id(), responsePlay.FromEmail)
 		}
 		return nil
@@ -269,9 +495,20 @@ func resourcePagerDutyResponsePlayRead(d *schema.ResourceData, meta interface{})
 				return resource.NonRetryableError(err)
 			}
 			log.Printf("[INFO] Read PagerDuty response play initial responders: %s", d.Get("responder"))
-			if err := d.Set("responder", flattenResponders(responsePlay.Responders)); err != nil {
+			responders := flattenResponders(responsePlay.Responders)
+			keepResponsePlayResponderTypes(d, responders)
+			if err := d.Set("responder", responders); err != nil {
 				return resource.NonRetryableError(err)
 			}
+			var refs []*responsePlayReference
+			for i, r := range responsePlay.Responders {
+				refs = append(refs, &responsePlayReference{attr: fmt.Sprintf("responder.%d", i), Type: r.Type, ID: r.ID})
+			}
+			if names, err := lookupResponsePlayReferences(meta.(*Config), client, refs); err != nil {
+				log.Printf("[WARN] Couldn't read the responders of PagerDuty response play %s: %s", d.Id(), err)
+			} else {
+				d.Set("responder_names", names)
+			}
 			d.Set("from", from)
 			d.Set("name", responsePlay.Name)
 			d.Set("type", responsePlay.Type)
@@ -434,6 +671,22 @@ func flattenResponders(rlist []*pagerduty.Responder) []interface{} {
 	return resps
 }
 
+// keepResponsePlayResponderTypes keeps the types of the responders as
+// configured, with or without the _reference suffix, when they reference
+// the same kind of object as the types returned by PagerDuty.
+func keepResponsePlayResponderTypes(d *schema.ResourceData, responders []interface{}) {
+	for i, r := range responders {
+		m := r.(map[string]interface{})
+		old, ok := d.Get(fmt.Sprintf("responder.%d.type", i)).(string)
+		if !ok || old == "" {
+			continue
+		}
+		if strings.TrimSuffix(old, "_reference") == strings.TrimSuffix(m["type"].(string), "_reference") {
+			m["type"] = old
+		}
+	}
+}
+
 func flattenRSServices(services []*pagerduty.ServiceReference) []interface{} {
 	var flatServiceList []interface{}
 
@@ -463,12 +716,17 @@ func flattenRSTeams(teams []*pagerduty.TeamReference) []interface{} {
 func resourcePagerDutyResponsePlayImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	client, _ := meta.(*Config).Client()
 
+	// The from email is optional in the importation ID when the provider sets
+	// default_from_email.
 	ids := strings.SplitN(d.Id(), ".", 2)
+	rid, from := ids[0], ""
+	if len(ids) == 2 {
+		from = ids[1]
+	}
 
-	if len(ids) != 2 {
-		return []*schema.ResourceData{}, fmt.Errorf("Error importing pagerduty_response_play. Expecting an importation ID formed as '<response_play_id>.<from_email>'")
+	if rid == "" || (from == "" && meta.(*Config).DefaultFromEmail == "") {
+		return []*schema.ResourceData{}, fmt.Errorf("Error importing pagerduty_response_play. Expecting an importation ID formed as '<response_play_id>.<from_email>', or '<response_play_id>' when the provider sets default_from_email")
 	}
-	rid, from := ids[0], ids[1]
 	log.Printf("[INFO] Importing PagerDuty response play: %s (From: %s)", rid, from)
 
 	_, _, err := client.ResponsePlays.Get(rid, from)
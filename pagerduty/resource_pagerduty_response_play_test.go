@@ -2,10 +2,15 @@ package pagerduty
 
 import (
 	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
@@ -47,6 +52,214 @@ func TestAccPagerDutyResponsePlay_Basic(t *testing.T) {
 	})
 }
 
+func TestPagerDutyResponsePlay_DefaultFromEmail(t *testing.T) {
+	var froms []string
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		froms = append(froms, r.Header.Get("From"))
+		switch {
+		case r.Method == "POST" && r.URL.Path == "/response_plays":
+			w.WriteHeader(http.StatusCreated)
+			w.Write([]byte(`{"response_play":{"id":"PRP1234"}}`))
+		case r.Method == "GET" && r.URL.Path == "/response_plays/PRP1234":
+			w.Write([]byte(`{"response_play":{"id":"PRP1234","name":"foo","type":"response_play"}}`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	}))
+	defer srv.Close()
+
+	config := &Config{Token: "foo", ApiUrlOverride: srv.URL, SkipCredsValidation: true, DefaultFromEmail: "owner@foo.com"}
+
+	d := schema.TestResourceDataRaw(t, resourcePagerDutyResponsePlay().Schema, map[string]interface{}{
+		"name": "foo",
+	})
+	if err := resourcePagerDutyResponsePlay().Create(d, config); err != nil {
+		t.Fatal(err)
+	}
+	if d.Id() != "PRP1234" || d.Get("from").(string) != "" {
+		t.Errorf("unexpected state %q, from %q", d.Id(), d.Get("from"))
+	}
+
+	d = resourcePagerDutyResponsePlay().TestResourceData()
+	d.SetId("PRP1234")
+	if _, err := resourcePagerDutyResponsePlay().Importer.State(d, config); err != nil {
+		t.Fatal(err)
+	}
+	if d.Id() != "PRP1234" || d.Get("from").(string) != "" {
+		t.Errorf("unexpected imported state %q, from %q", d.Id(), d.Get("from"))
+	}
+
+	d.SetId("PRP1234.responder@foo.com")
+	if _, err := resourcePagerDutyResponsePlay().Importer.State(d, config); err != nil {
+		t.Fatal(err)
+	}
+	if d.Get("from").(string) != "responder@foo.com" {
+		t.Errorf("expected the from email of the importation ID, got %q", d.Get("from"))
+	}
+
+	for i, want := range []string{"owner@foo.com", "owner@foo.com", "owner@foo.com", "responder@foo.com"} {
+		if i >= len(froms) || froms[i] != want {
+			t.Fatalf("expected the From headers %v to start with %q at %d", froms, want, i)
+		}
+	}
+
+	config.DefaultFromEmail = ""
+	d.SetId("PRP1234")
+	if _, err := resourcePagerDutyResponsePlay().Importer.State(d, config); err == nil {
+		t.Error("expected an error importing a bare ID without default_from_email")
+	}
+}
+
+func TestLookupResponsePlayReferences(t *testing.T) {
+	requests := make(map[string]int)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests[r.URL.Path]++
+		switch r.URL.Path {
+		case "/users":
+			w.Write([]byte(`{"users":[{"id":"PUSER12","name":"Jane Doe"}],"limit":25,"more":false}`))
+		case "/escalation_policies":
+			if r.URL.Query().Get("offset") == "" {
+				w.Write([]byte(`{"escalation_policies":[{"id":"PEP1234","name":"Engineering"}],"limit":1,"more":true}`))
+				return
+			}
+			w.Write([]byte(`{"escalation_policies":[{"id":"PEP5678","name":"Support"}],"limit":1,"more":false}`))
+		case "/escalation_policies/PEPNEW1":
+			w.Write([]byte(`{"escalation_policy":{"id":"PEPNEW1","name":"Operations"}}`))
+		case "/teams":
+			w.Write([]byte(`{"teams":[],"limit":25,"more":false}`))
+		case "/teams/PTEAM12":
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"error":{"message":"Not Found","code":2100}}`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	}))
+	defer srv.Close()
+
+	config := &Config{Token: "foo", ApiUrlOverride: srv.URL, SkipCredsValidation: true, responsePlayReferenceNames: newResponsePlayReferenceNames()}
+	client, err := config.Client()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	responders := []*responsePlayReference{
+		{attr: "responder.0", Type: "escalation_policy_reference", ID: "PEP1234"},
+		{attr: "responder.1", Type: "user_reference", ID: "PUSER12"},
+		{attr: "responder.2", Type: "escalation_policy", ID: "PEP5678"},
+	}
+	names, err := lookupResponsePlayReferences(config, client, responders)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(names, []string{"Engineering", "Jane Doe", "Support"}) {
+		t.Errorf("unexpected names %v", names)
+	}
+	if _, err := lookupResponsePlayReferences(config, client, responders); err != nil {
+		t.Fatal(err)
+	}
+	if requests["/escalation_policies"] != 2 || requests["/users"] != 1 {
+		t.Errorf("expected each kind of reference to be listed once, got %v", requests)
+	}
+
+	// An object missing from the listing, such as one created since, is read
+	// on its own.
+	names, err = lookupResponsePlayReferences(config, client, []*responsePlayReference{
+		{attr: "responder.0", Type: "escalation_policy_reference", ID: "PEPNEW1"},
+	})
+	if err != nil || !reflect.DeepEqual(names, []string{"Operations"}) {
+		t.Errorf("unexpected names %v, %v", names, err)
+	}
+
+	_, err = lookupResponsePlayReferences(config, client, []*responsePlayReference{
+		{attr: "subscriber.0", Type: "team_reference", ID: "PTEAM12"},
+	})
+	if err == nil || err.Error() != "subscriber.0 references the team PTEAM12, which doesn't exist" {
+		t.Errorf("unexpected error %v", err)
+	}
+}
+
+func TestPagerDutyResponsePlay_ResponderTypes(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/response_plays/PRP1234":
+			w.Write([]byte(`{"response_play":{"id":"PRP1234","name":"foo","responders":[{"id":"PEP1234","type":"escalation_policy"},{"id":"PUSER12","type":"user"}]}}`))
+		case "/escalation_policies":
+			w.Write([]byte(`{"escalation_policies":[{"id":"PEP1234","name":"Engineering"}]}`))
+		case "/users":
+			w.Write([]byte(`{"users":[{"id":"PUSER12","name":"Jane Doe"}]}`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	}))
+	defer srv.Close()
+
+	config := &Config{Token: "foo", ApiUrlOverride: srv.URL, SkipCredsValidation: true}
+
+	for _, types := range [][]string{
+		{"escalation_policy_reference", "user_reference"},
+		{"escalation_policy", "user"},
+	} {
+		d := schema.TestResourceDataRaw(t, resourcePagerDutyResponsePlay().Schema, map[string]interface{}{
+			"name": "foo",
+			"from": "owner@foo.com",
+			"responder": []interface{}{
+				map[string]interface{}{"id": "PEP1234", "type": types[0]},
+				map[string]interface{}{"id": "PUSER12", "type": types[1]},
+			},
+		})
+		d.SetId("PRP1234")
+
+		if err := resourcePagerDutyResponsePlay().Read(d, config); err != nil {
+			t.Fatal(err)
+		}
+		if got := []string{d.Get("responder.0.type").(string), d.Get("responder.1.type").(string)}; !reflect.DeepEqual(got, types) {
+			t.Errorf("expected the responder types %v to be kept, got %v", types, got)
+		}
+		if names := d.Get("responder_names").([]interface{}); !reflect.DeepEqual(names, []interface{}{"Engineering", "Jane Doe"}) {
+			t.Errorf("unexpected responder names %v", names)
+		}
+	}
+
+	// Imported response plays get the types returned by PagerDuty.
+	d := resourcePagerDutyResponsePlay().TestResourceData()
+	d.SetId("PRP1234")
+	d.Set("from", "owner@foo.com")
+	if err := resourcePagerDutyResponsePlay().Read(d, config); err != nil {
+		t.Fatal(err)
+	}
+	if got := d.Get("responder.0.type").(string); got != "escalation_policy" {
+		t.Errorf("expected the type returned by PagerDuty, got %q", got)
+	}
+}
+
+func TestAccPagerDutyResponsePlay_InvalidReferenceType(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "pagerduty_response_play" "foo" {
+  name = "foo"
+  from = "foo@example.com"
+  subscriber {
+    type = "user"
+    id   = "PUSER12"
+  }
+}
+`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`"user" is an invalid value for argument subscriber.0.type`),
+			},
+		},
+	})
+}
+
 func testAccCheckPagerDutyResponsePlayDestroy(s *terraform.State) error {
 	client, _ := testAccProvider.Meta().(*Config).Client()
 	for _, r := range s.RootModule().Resources {
@@ -48,6 +48,7 @@ func resourcePagerDutyRuleset() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"deletion_protection": deletionProtectionSchema(),
 		},
 	}
 }
@@ -154,6 +155,7 @@ func resourcePagerDutyRulesetCreate(d *schema.ResourceData, meta interface{}) er
 
 func resourcePagerDutyRulesetRead(d *schema.ResourceData, meta interface{}) error {
 	log.Printf("[INFO] Reading PagerDuty ruleset: %s", d.Id())
+	setDefaultDeletionProtection(d)
 	return fetchPagerDutyRuleset(d, meta, handleNotFoundError)
 
 }
@@ -172,6 +174,10 @@ func resourcePagerDutyRulesetUpdate(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourcePagerDutyRulesetDelete(d *schema.ResourceData, meta interface{}) error {
+	if err := checkDeletionProtection(d, "pagerduty_ruleset"); err != nil {
+		return err
+	}
+
 	client, _ := meta.(*Config).Client()
 
 	log.Printf("[INFO] Deleting PagerDuty ruleset: %s", d.Id())
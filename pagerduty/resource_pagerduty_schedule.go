@@ -152,6 +152,8 @@ func resourcePagerDutySchedule() *schema.Resource {
 					Type: schema.TypeString,
 				},
 			},
+			"deletion_protection": deletionProtectionSchema(),
+			"detach_on_destroy":   detachOnDestroySchema(),
 		},
 	}
 }
@@ -210,6 +212,8 @@ func resourcePagerDutyScheduleRead(d *schema.ResourceData, meta interface{}) err
 
 	log.Printf("[INFO] Reading PagerDuty schedule: %s", d.Id())
 
+	setDefaultDeletionProtection(d)
+
 	retryErr := resource.Retry(30*time.Second, func() *resource.RetryError {
 		if schedule, _, err := client.Schedules.Get(d.Id(), &pagerduty.GetScheduleOptions{}); err != nil {
 			time.Sleep(2 * time.Second)
@@ -313,24 +317,39 @@ func resourcePagerDutyScheduleUpdate(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourcePagerDutyScheduleDelete(d *schema.ResourceData, meta interface{}) error {
+	if err := checkDeletionProtection(d, "pagerduty_schedule"); err != nil {
+		return err
+	}
+
 	client, _ := meta.(*Config).Client()
 
-	log.Printf("[INFO] Deleting PagerDuty schedule: %s", d.Id())
+	escalationPolicies, err := fetchScheduleEscalationPolicies(client, d.Id())
+	if err != nil {
+		return err
+	}
 
-	// Retrying to give other resources (such as escalation policies) to delete
-	retryErr := resource.Retry(2*time.Minute, func() *resource.RetryError {
-		if _, err := client.Schedules.Delete(d.Id()); err != nil {
-			if isErrCode(err, 400) {
-				return resource.RetryableError(err)
+	if len(escalationPolicies) > 0 {
+		if !d.Get("detach_on_destroy").(bool) {
+			var dependents []string
+			for _, ep := range escalationPolicies {
+				dependents = append(dependents, fmt.Sprintf("escalation policy %q (%s)", ep.Name, ep.ID))
 			}
+			return fmt.Errorf("pagerduty_schedule %q (%s) can't be deleted because it is used by:\n%s\nRemove the schedule from these escalation policies first, or set detach_on_destroy to true", d.Get("name").(string), d.Id(), formatDependents(dependents))
+		}
 
-			return resource.NonRetryableError(err)
+		for _, ep := range escalationPolicies {
+			if err := replaceEscalationPolicyTarget(client, ep, "schedule_reference", d.Id(), nil); err != nil {
+				return fmt.Errorf("error detaching pagerduty_schedule %s: %s", d.Id(), err)
+			}
 		}
-		return nil
-	})
-	if retryErr != nil {
-		time.Sleep(2 * time.Second)
-		return retryErr
+	}
+
+	log.Printf("[INFO] Deleting PagerDuty schedule: %s", d.Id())
+
+	// The escalation policies using the schedule were checked above, so a
+	// schedule that still can't be deleted is reported right away.
+	if _, err := client.Schedules.Delete(d.Id()); err != nil {
+		return err
 	}
 
 	d.SetId("")
@@ -338,6 +357,120 @@ func resourcePagerDutyScheduleDelete(d *schema.ResourceData, meta interface{}) e
 	return nil
 }
 
+// fetchScheduleEscalationPolicies returns the escalation policies that still target the schedule.
+// Escalation policies that have been deleted in the meantime are skipped.
+func fetchScheduleEscalationPolicies(client *pagerduty.Client, id string) ([]*pagerduty.EscalationPolicy, error) {
+	schedule, _, err := client.Schedules.Get(id, &pagerduty.GetScheduleOptions{})
+	if err != nil {
+		if isErrCode(err, 404) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var escalationPolicies []*pagerduty.EscalationPolicy
+	for _, ref := range schedule.EscalationPolicies {
+		ep, _, err := client.EscalationPolicies.Get(ref.ID, &pagerduty.GetEscalationPolicyOptions{})
+		if err != nil {
+			if isErrCode(err, 404) {
+				continue
+			}
+			return nil, err
+		}
+		escalationPolicies = append(escalationPolicies, ep)
+	}
+
+	return escalationPolicies, nil
+}
+
+func hasScheduleLayerUser(users []*pagerduty.UserReferenceWrapper, id string) bool {
+	for _, u := range users {
+		if u.User != nil && u.User.ID == id {
+			return true
+		}
+	}
+	return false
+}
+
+// replaceScheduleUser replaces a user with another one in every layer of a
+// schedule. An empty replacementID removes the user instead.
+func replaceScheduleUser(client *pagerduty.Client, schedule *pagerduty.Schedule, userID, replacementID string) error {
+	var layers []*pagerduty.ScheduleLayer
+
+	for _, l := range schedule.ScheduleLayers {
+		var users []*pagerduty.UserReferenceWrapper
+		for _, u := range l.Users {
+			if u.User == nil || u.User.ID != userID {
+				users = append(users, u)
+				continue
+			}
+
+			if replacementID == "" || hasScheduleLayerUser(l.Users, replacementID) || hasScheduleLayerUser(users, replacementID) {
+				continue
+			}
+
+			log.Printf("[INFO] Replacing user %s with user %s in layer %s of PagerDuty schedule %s", userID, replacementID, l.ID, schedule.ID)
+
+			users = append(users, &pagerduty.UserReferenceWrapper{
+				User: &pagerduty.UserReference{
+					ID:   replacementID,
+					Type: "user_reference",
+				},
+			})
+		}
+
+		if len(users) == 0 && !scheduleLayerEnded(l) {
+			return fmt.Errorf("layer %q of schedule %q (%s) would be left without any users if user %s was removed from it", l.Name, schedule.Name, schedule.ID, userID)
+		}
+
+		if len(users) == 0 {
+			users = l.Users
+		}
+
+		layers = append(layers, &pagerduty.ScheduleLayer{
+			ID:                        l.ID,
+			Name:                      l.Name,
+			Start:                     l.Start,
+			End:                       l.End,
+			RotationVirtualStart:      l.RotationVirtualStart,
+			RotationTurnLengthSeconds: l.RotationTurnLengthSeconds,
+			Restrictions:              l.Restrictions,
+			Users:                     users,
+		})
+	}
+
+	update := &pagerduty.Schedule{
+		Name:           schedule.Name,
+		TimeZone:       schedule.TimeZone,
+		Description:    schedule.Description,
+		ScheduleLayers: layers,
+		Teams:          schedule.Teams,
+	}
+
+	if replacementID == "" {
+		log.Printf("[INFO] Removing user %s from PagerDuty schedule %s", userID, schedule.ID)
+	}
+
+	if _, _, err := client.Schedules.Update(schedule.ID, update, &pagerduty.UpdateScheduleOptions{}); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func scheduleLayerEnded(l *pagerduty.ScheduleLayer) bool {
+	if l.End == "" {
+		return false
+	}
+
+	end, err := timeToUTC(l.End)
+	if err != nil {
+		return false
+	}
+
+	return time.Now().UTC().After(end)
+}
+
 func expandScheduleLayers(v interface{}) ([]*pagerduty.ScheduleLayer, error) {
 	var scheduleLayers []*pagerduty.ScheduleLayer
 
@@ -1,8 +1,12 @@
 package pagerduty
 
 import (
+	"encoding/json"
 	"fmt"
 	"log"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
 	"regexp"
 	"strings"
 	"testing"
@@ -49,6 +53,85 @@ func testSweepSchedule(region string) error {
 	return nil
 }
 
+func TestPagerDutyScheduleDelete_FailsFast(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/schedules/PSCHED1":
+			w.Write([]byte(`{"schedule":{"id":"PSCHED1","name":"foo"}}`))
+		case r.Method == "DELETE" && r.URL.Path == "/schedules/PSCHED1":
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"error":{"message":"Schedule can't be deleted","code":2001}}`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	}))
+	defer srv.Close()
+
+	d := resourcePagerDutySchedule().TestResourceData()
+	d.SetId("PSCHED1")
+
+	config := &Config{Token: "foo", ApiUrlOverride: srv.URL, SkipCredsValidation: true}
+	start := time.Now()
+	if err := resourcePagerDutySchedule().Delete(d, config); err == nil {
+		t.Fatal("expected the delete to fail")
+	}
+	if elapsed := time.Since(start); elapsed > 10*time.Second {
+		t.Errorf("expected the delete to fail right away, took %s", elapsed)
+	}
+}
+
+func TestReplaceScheduleUser(t *testing.T) {
+	var updated pagerduty.SchedulePayload
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "PUT" || r.URL.Path != "/schedules/PSCHED1" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		if err := json.NewDecoder(r.Body).Decode(&updated); err != nil {
+			t.Error(err)
+		}
+		w.Write([]byte(`{"schedule":{"id":"PSCHED1"}}`))
+	}))
+	defer srv.Close()
+
+	client, err := (&Config{Token: "foo", ApiUrlOverride: srv.URL, SkipCredsValidation: true}).Client()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	user := func(id string) *pagerduty.UserReferenceWrapper {
+		return &pagerduty.UserReferenceWrapper{User: &pagerduty.UserReference{ID: id, Type: "user_reference"}}
+	}
+	schedule := &pagerduty.Schedule{
+		ID: "PSCHED1",
+		ScheduleLayers: []*pagerduty.ScheduleLayer{
+			{ID: "L1", Users: []*pagerduty.UserReferenceWrapper{user("POLD001"), user("PNEW001")}},
+			{ID: "L2", Users: []*pagerduty.UserReferenceWrapper{user("POLD001"), user("POTHER1"), user("POLD001")}},
+		},
+	}
+
+	if err := replaceScheduleUser(client, schedule, "POLD001", "PNEW001"); err != nil {
+		t.Fatal(err)
+	}
+
+	want := [][]string{{"PNEW001"}, {"PNEW001", "POTHER1"}}
+	if updated.Schedule == nil || len(updated.Schedule.ScheduleLayers) != len(want) {
+		t.Fatalf("unexpected update %v", updated.Schedule)
+	}
+	for i, l := range updated.Schedule.ScheduleLayers {
+		var got []string
+		for _, u := range l.Users {
+			got = append(got, u.User.ID)
+		}
+		if !reflect.DeepEqual(got, want[i]) {
+			t.Errorf("layer %s: got users %v, want %v", l.ID, got, want[i])
+		}
+	}
+}
+
 func TestAccPagerDutySchedule_Basic(t *testing.T) {
 	username := fmt.Sprintf("tf-%s", acctest.RandString(5))
 	email := fmt.Sprintf("%s@foo.com", username)
@@ -369,6 +452,117 @@ func TestAccPagerDutySchedule_Multi(t *testing.T) {
 	})
 }
 
+func TestAccPagerDutySchedule_DetachOnDestroy(t *testing.T) {
+	username := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	email := fmt.Sprintf("%s@foo.com", username)
+	schedule := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	escalationPolicy := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	location := "America/New_York"
+	start := timeNowInLoc(location).Add(24 * time.Hour).Round(1 * time.Hour).Format(time.RFC3339)
+	rotationVirtualStart := timeNowInLoc(location).Add(24 * time.Hour).Round(1 * time.Hour).Format(time.RFC3339)
+
+	// The escalation policy is created outside of Terraform, so Terraform
+	// can't remove the schedule from it before destroying the schedule.
+	var escalationPolicyID string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPagerDutyScheduleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckPagerDutyScheduleDetachOnDestroyConfig(username, email, schedule, location, start, rotationVirtualStart, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyScheduleExists("pagerduty_schedule.foo"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_schedule.foo", "detach_on_destroy", "false"),
+					testAccCreatePagerDutyScheduleEscalationPolicy(escalationPolicy, &escalationPolicyID),
+				),
+			},
+			{
+				Config:      testAccCheckPagerDutyScheduleDetachOnDestroyConfigRemoved(username, email),
+				ExpectError: regexp.MustCompile("can't be deleted because it is used by"),
+			},
+			{
+				Config: testAccCheckPagerDutyScheduleDetachOnDestroyConfig(username, email, schedule, location, start, rotationVirtualStart, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"pagerduty_schedule.foo", "detach_on_destroy", "true"),
+				),
+			},
+			{
+				Config: testAccCheckPagerDutyScheduleDetachOnDestroyConfigRemoved(username, email),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyScheduleDetached(&escalationPolicyID),
+				),
+			},
+		},
+	})
+}
+
+// testAccCreatePagerDutyScheduleEscalationPolicy creates an escalation policy
+// that targets both pagerduty_schedule.foo and pagerduty_user.foo.
+func testAccCreatePagerDutyScheduleEscalationPolicy(name string, id *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if *id != "" {
+			return nil
+		}
+
+		client, _ := testAccProvider.Meta().(*Config).Client()
+
+		ep, _, err := client.EscalationPolicies.Create(&pagerduty.EscalationPolicy{
+			Name: name,
+			EscalationRules: []*pagerduty.EscalationRule{
+				{
+					EscalationDelayInMinutes: 10,
+					Targets: []*pagerduty.EscalationTargetReference{
+						{
+							ID:   s.RootModule().Resources["pagerduty_schedule.foo"].Primary.ID,
+							Type: "schedule_reference",
+						},
+						{
+							ID:   s.RootModule().Resources["pagerduty_user.foo"].Primary.ID,
+							Type: "user_reference",
+						},
+					},
+				},
+			},
+		})
+		if err != nil {
+			return err
+		}
+
+		*id = ep.ID
+
+		return nil
+	}
+}
+
+func testAccCheckPagerDutyScheduleDetached(id *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client, _ := testAccProvider.Meta().(*Config).Client()
+
+		ep, _, err := client.EscalationPolicies.Get(*id, &pagerduty.GetEscalationPolicyOptions{})
+		if err != nil {
+			return err
+		}
+
+		for _, rule := range ep.EscalationRules {
+			for _, target := range rule.Targets {
+				if target.Type == "schedule_reference" {
+					return fmt.Errorf("Escalation policy %s still targets schedule %s", ep.ID, target.ID)
+				}
+			}
+		}
+
+		if _, err := client.EscalationPolicies.Delete(ep.ID); err != nil {
+			return err
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckPagerDutyScheduleDestroy(s *terraform.State) error {
 	client, _ := testAccProvider.Meta().(*Config).Client()
 	for _, r := range s.RootModule().Resources {
@@ -800,3 +994,36 @@ resource "pagerduty_schedule" "foo" {
 }
 `, username, email, team, schedule, location, start, rotationVirtualStart)
 }
+
+func testAccCheckPagerDutyScheduleDetachOnDestroyConfig(username, email, schedule, location, start, rotationVirtualStart string, detach bool) string {
+	return fmt.Sprintf(`
+resource "pagerduty_user" "foo" {
+  name  = "%s"
+  email = "%s"
+}
+
+resource "pagerduty_schedule" "foo" {
+  name      = "%s"
+  time_zone = "%s"
+
+  detach_on_destroy = %t
+
+  layer {
+    name                         = "foo"
+    start                        = "%s"
+    rotation_virtual_start       = "%s"
+    rotation_turn_length_seconds = 86400
+    users                        = [pagerduty_user.foo.id]
+  }
+}
+`, username, email, schedule, location, detach, start, rotationVirtualStart)
+}
+
+func testAccCheckPagerDutyScheduleDetachOnDestroyConfigRemoved(username, email string) string {
+	return fmt.Sprintf(`
+resource "pagerduty_user" "foo" {
+  name  = "%s"
+  email = "%s"
+}
+`, username, email)
+}
@@ -269,6 +269,7 @@ func resourcePagerDutyService() *schema.Resource {
 					},
 				},
 			},
+			"deletion_protection": deletionProtectionSchema(),
 		},
 	}
 }
@@ -395,6 +396,7 @@ func resourcePagerDutyServiceCreate(d *schema.ResourceData, meta interface{}) er
 
 func resourcePagerDutyServiceRead(d *schema.ResourceData, meta interface{}) error {
 	log.Printf("[INFO] Reading PagerDuty service %s", d.Id())
+	setDefaultDeletionProtection(d)
 	return fetchService(d, meta, handleNotFoundError)
 }
 
@@ -417,6 +419,10 @@ func resourcePagerDutyServiceUpdate(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourcePagerDutyServiceDelete(d *schema.ResourceData, meta interface{}) error {
+	if err := checkDeletionProtection(d, "pagerduty_service"); err != nil {
+		return err
+	}
+
 	client, _ := meta.(*Config).Client()
 
 	log.Printf("[INFO] Deleting PagerDuty service %s", d.Id())
@@ -0,0 +1,457 @@
+package pagerduty
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/heimweh/go-pagerduty/pagerduty"
+)
+
+func resourcePagerDutyServiceDependencies() *schema.Resource {
+	return &schema.Resource{
+		Create:        resourcePagerDutyServiceDependenciesCreate,
+		Read:          resourcePagerDutyServiceDependenciesRead,
+		Update:        resourcePagerDutyServiceDependenciesUpdate,
+		Delete:        resourcePagerDutyServiceDependenciesDelete,
+		CustomizeDiff: resourcePagerDutyServiceDependenciesCustomizeDiff,
+		Importer: &schema.ResourceImporter{
+			State: resourcePagerDutyServiceDependenciesImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"dependent_service": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+							ValidateFunc: validateValueFunc([]string{
+								"business_service",
+								"service",
+							}),
+						},
+					},
+				},
+			},
+			"supporting_service": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validateValueFunc([]string{
+								"business_service",
+								"service",
+							}),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// serviceDependencyGraph holds the supporting services that
+// pagerduty_service_dependencies resources plan for their dependent service,
+// so that cycles spanning several resources are caught at plan time.
+type serviceDependencyGraph struct {
+	mu      sync.Mutex
+	planned map[string][]*pagerduty.ServiceObj
+}
+
+func newServiceDependencyGraph() *serviceDependencyGraph {
+	return &serviceDependencyGraph{planned: make(map[string][]*pagerduty.ServiceObj)}
+}
+
+func (g *serviceDependencyGraph) plan(dependentID string, supporting []*pagerduty.ServiceObj) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.planned[dependentID] = supporting
+}
+
+func (g *serviceDependencyGraph) supporting(dependentID string) ([]*pagerduty.ServiceObj, bool) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	s, ok := g.planned[dependentID]
+	return s, ok
+}
+
+func expandServiceDependenciesDependent(v interface{}) *pagerduty.ServiceObj {
+	for _, s := range v.([]interface{}) {
+		sm := s.(map[string]interface{})
+		return &pagerduty.ServiceObj{
+			ID:   sm["id"].(string),
+			Type: sm["type"].(string),
+		}
+	}
+	return nil
+}
+
+func expandServiceDependenciesSupporting(v interface{}) []*pagerduty.ServiceObj {
+	var services []*pagerduty.ServiceObj
+
+	for _, s := range v.(*schema.Set).List() {
+		sm := s.(map[string]interface{})
+		services = append(services, &pagerduty.ServiceObj{
+			ID:   sm["id"].(string),
+			Type: sm["type"].(string),
+		})
+	}
+
+	return services
+}
+
+func flattenServiceDependenciesSupporting(services []*pagerduty.ServiceObj) []interface{} {
+	var flattened []interface{}
+
+	for _, s := range services {
+		flattened = append(flattened, map[string]interface{}{
+			"id":   s.ID,
+			"type": convertType(s.Type),
+		})
+	}
+
+	return flattened
+}
+
+// fetchSupportingServices returns the services the dependent service
+// directly depends on. The API also returns relationships in which the
+// service is the supporting one; those are left out.
+func fetchSupportingServices(client *pagerduty.Client, dependent *pagerduty.ServiceObj) ([]*pagerduty.ServiceObj, error) {
+	var supporting []*pagerduty.ServiceObj
+
+	dependencies, _, err := client.ServiceDependencies.GetServiceDependenciesForType(dependent.ID, dependent.Type)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, rel := range dependencies.Relationships {
+		if rel.DependentService == nil || rel.SupportingService == nil || rel.DependentService.ID != dependent.ID {
+			continue
+		}
+		supporting = append(supporting, &pagerduty.ServiceObj{
+			ID:   rel.SupportingService.ID,
+			Type: convertType(rel.SupportingService.Type),
+		})
+	}
+
+	return supporting, nil
+}
+
+// diffSupportingServices returns the services in want that are missing from
+// have, and the services in have that are missing from want.
+func diffSupportingServices(have, want []*pagerduty.ServiceObj) (associate, disassociate []*pagerduty.ServiceObj) {
+	key := func(s *pagerduty.ServiceObj) string {
+		return convertType(s.Type) + "." + s.ID
+	}
+
+	haveKeys := make(map[string]bool)
+	for _, s := range have {
+		haveKeys[key(s)] = true
+	}
+	wantKeys := make(map[string]bool)
+	for _, s := range want {
+		wantKeys[key(s)] = true
+		if !haveKeys[key(s)] {
+			associate = append(associate, s)
+		}
+	}
+	for _, s := range have {
+		if !wantKeys[key(s)] {
+			disassociate = append(disassociate, s)
+		}
+	}
+
+	return associate, disassociate
+}
+
+func buildServiceDependencies(dependent *pagerduty.ServiceObj, supporting []*pagerduty.ServiceObj) *pagerduty.ListServiceDependencies {
+	var rels []*pagerduty.ServiceDependency
+
+	for _, s := range supporting {
+		rels = append(rels, &pagerduty.ServiceDependency{
+			SupportingService: &pagerduty.ServiceObj{ID: s.ID, Type: convertType(s.Type)},
+			DependentService:  &pagerduty.ServiceObj{ID: dependent.ID, Type: convertType(dependent.Type)},
+		})
+	}
+
+	return &pagerduty.ListServiceDependencies{Relationships: rels}
+}
+
+// applyServiceDependencies associates and disassociates only the supporting
+// services that differ from what PagerDuty currently has, so unchanged
+// dependencies are never interrupted.
+func applyServiceDependencies(client *pagerduty.Client, dependent *pagerduty.ServiceObj, want []*pagerduty.ServiceObj) error {
+	have, err := fetchSupportingServices(client, dependent)
+	if err != nil {
+		return err
+	}
+
+	associate, disassociate := diffSupportingServices(have, want)
+
+	if len(associate) > 0 {
+		log.Printf("[INFO] Associating %d supporting services with PagerDuty %s %s", len(associate), dependent.Type, dependent.ID)
+
+		retryErr := resource.Retry(30*time.Second, func() *resource.RetryError {
+			if _, _, err := client.ServiceDependencies.AssociateServiceDependencies(buildServiceDependencies(dependent, associate)); err != nil {
+				if isErrCode(err, 404) {
+					return resource.RetryableError(err)
+				}
+				return resource.NonRetryableError(err)
+			}
+			return nil
+		})
+		if retryErr != nil {
+			time.Sleep(2 * time.Second)
+			return retryErr
+		}
+	}
+
+	if len(disassociate) > 0 {
+		log.Printf("[INFO] Disassociating %d supporting services from PagerDuty %s %s", len(disassociate), dependent.Type, dependent.ID)
+
+		retryErr := resource.Retry(30*time.Second, func() *resource.RetryError {
+			if _, _, err := client.ServiceDependencies.DisassociateServiceDependencies(buildServiceDependencies(dependent, disassociate)); err != nil {
+				if isErrCode(err, 404) {
+					return resource.RetryableError(err)
+				}
+				return resource.NonRetryableError(err)
+			}
+			return nil
+		})
+		if retryErr != nil {
+			time.Sleep(2 * time.Second)
+			return retryErr
+		}
+	}
+
+	return nil
+}
+
+func resourcePagerDutyServiceDependenciesCreate(d *schema.ResourceData, meta interface{}) error {
+	client, _ := meta.(*Config).Client()
+
+	dependent := expandServiceDependenciesDependent(d.Get("dependent_service"))
+	supporting := expandServiceDependenciesSupporting(d.Get("supporting_service"))
+
+	if err := applyServiceDependencies(client, dependent, supporting); err != nil {
+		return err
+	}
+
+	d.SetId(dependent.ID)
+
+	return resourcePagerDutyServiceDependenciesRead(d, meta)
+}
+
+func resourcePagerDutyServiceDependenciesRead(d *schema.ResourceData, meta interface{}) error {
+	client, _ := meta.(*Config).Client()
+
+	dependent := expandServiceDependenciesDependent(d.Get("dependent_service"))
+
+	log.Printf("[INFO] Reading PagerDuty service dependencies of %s %s", dependent.Type, dependent.ID)
+
+	// Pausing to let the PD API sync.
+	time.Sleep(1 * time.Second)
+	return resource.Retry(30*time.Second, func() *resource.RetryError {
+		supporting, err := fetchSupportingServices(client, dependent)
+		if err != nil {
+			if isErrCode(err, 404) {
+				log.Printf("[WARN] Removing %s because the dependent service is gone", d.Id())
+				d.SetId("")
+				return nil
+			}
+			if isErrCode(err, 500) || isErrCode(err, 429) {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+
+		if len(supporting) == 0 {
+			log.Printf("[WARN] Removing %s because it has no supporting services left", d.Id())
+			d.SetId("")
+			return nil
+		}
+
+		if err := d.Set("supporting_service", flattenServiceDependenciesSupporting(supporting)); err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+}
+
+func resourcePagerDutyServiceDependenciesUpdate(d *schema.ResourceData, meta interface{}) error {
+	client, _ := meta.(*Config).Client()
+
+	dependent := expandServiceDependenciesDependent(d.Get("dependent_service"))
+	supporting := expandServiceDependenciesSupporting(d.Get("supporting_service"))
+
+	log.Printf("[INFO] Updating PagerDuty service dependencies of %s %s", dependent.Type, dependent.ID)
+
+	if err := applyServiceDependencies(client, dependent, supporting); err != nil {
+		return err
+	}
+
+	return resourcePagerDutyServiceDependenciesRead(d, meta)
+}
+
+func resourcePagerDutyServiceDependenciesDelete(d *schema.ResourceData, meta interface{}) error {
+	client, _ := meta.(*Config).Client()
+
+	dependent := expandServiceDependenciesDependent(d.Get("dependent_service"))
+	supporting := expandServiceDependenciesSupporting(d.Get("supporting_service"))
+
+	log.Printf("[INFO] Removing PagerDuty service dependencies of %s %s", dependent.Type, dependent.ID)
+
+	have, err := fetchSupportingServices(client, dependent)
+	if err != nil {
+		if isErrCode(err, 404) {
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	// Only the supporting services managed by this resource are removed.
+	keep, _ := diffSupportingServices(supporting, have)
+
+	if err := applyServiceDependencies(client, dependent, keep); err != nil {
+		return err
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func resourcePagerDutyServiceDependenciesCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	// Services that are created in the same apply have no ID yet and can't
+	// be part of a cycle that exists in PagerDuty already.
+	if !diff.NewValueKnown("dependent_service") || !diff.NewValueKnown("supporting_service") {
+		return nil
+	}
+
+	dependent := expandServiceDependenciesDependent(diff.Get("dependent_service"))
+	if dependent == nil || dependent.ID == "" {
+		return nil
+	}
+
+	var supporting []*pagerduty.ServiceObj
+	for _, s := range expandServiceDependenciesSupporting(diff.Get("supporting_service")) {
+		if s.ID != "" {
+			supporting = append(supporting, s)
+		}
+	}
+
+	config := meta.(*Config)
+	if config.serviceDependencyGraph != nil {
+		config.serviceDependencyGraph.plan(dependent.ID, supporting)
+	}
+
+	client, err := config.Client()
+	if err != nil {
+		return err
+	}
+
+	cycle, err := findServiceDependencyCycle(dependent, supporting, func(s *pagerduty.ServiceObj) ([]*pagerduty.ServiceObj, error) {
+		if config.serviceDependencyGraph != nil {
+			if planned, ok := config.serviceDependencyGraph.supporting(s.ID); ok {
+				return planned, nil
+			}
+		}
+
+		live, err := fetchSupportingServices(client, s)
+		if err != nil && isErrCode(err, 404) {
+			return nil, nil
+		}
+		return live, err
+	})
+	if err != nil {
+		return err
+	}
+
+	if cycle != nil {
+		return fmt.Errorf("service dependencies of %s %s would create a dependency cycle: %s", dependent.Type, dependent.ID, strings.Join(cycle, " -> "))
+	}
+
+	return nil
+}
+
+// findServiceDependencyCycle walks the supporting services of dependent,
+// using supportingOf to look up the next level, and returns the IDs along
+// the first path that leads back to dependent.
+func findServiceDependencyCycle(dependent *pagerduty.ServiceObj, supporting []*pagerduty.ServiceObj, supportingOf func(*pagerduty.ServiceObj) ([]*pagerduty.ServiceObj, error)) ([]string, error) {
+	visited := map[string]bool{dependent.ID: true}
+
+	var walk func(path []string, services []*pagerduty.ServiceObj) ([]string, error)
+	walk = func(path []string, services []*pagerduty.ServiceObj) ([]string, error) {
+		// Sorted so the reported cycle doesn't depend on set ordering. The
+		// slices are shared with the graph of the provider, so a copy is
+		// sorted.
+		services = append([]*pagerduty.ServiceObj(nil), services...)
+		sort.Slice(services, func(i, j int) bool { return services[i].ID < services[j].ID })
+
+		for _, s := range services {
+			if s.ID == dependent.ID {
+				return append(path, s.ID), nil
+			}
+			if visited[s.ID] {
+				continue
+			}
+			visited[s.ID] = true
+
+			next, err := supportingOf(s)
+			if err != nil {
+				return nil, err
+			}
+
+			cycle, err := walk(append(path, s.ID), next)
+			if cycle != nil || err != nil {
+				return cycle, err
+			}
+		}
+		return nil, nil
+	}
+
+	return walk([]string{dependent.ID}, supporting)
+}
+
+func resourcePagerDutyServiceDependenciesImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	ids := strings.Split(d.Id(), ".")
+
+	if len(ids) != 2 {
+		return []*schema.ResourceData{}, fmt.Errorf("Error importing pagerduty_service_dependencies. Expecting an importation ID formed as '<dependent_service_id>.<dependent_service_type>'")
+	}
+	id, serviceType := ids[0], ids[1]
+
+	d.SetId(id)
+	d.Set("dependent_service", []interface{}{map[string]interface{}{
+		"id":   id,
+		"type": convertType(serviceType),
+	}})
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,319 @@
+package pagerduty
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"regexp"
+	"sort"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/heimweh/go-pagerduty/pagerduty"
+)
+
+func TestAccPagerDutyServiceDependencies_Basic(t *testing.T) {
+	businessService := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	service := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	username := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	email := fmt.Sprintf("%s@foo.com", username)
+	escalationPolicy := fmt.Sprintf("tf-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPagerDutyServiceDependenciesDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckPagerDutyServiceDependenciesConfig(businessService, service, username, email, escalationPolicy, "pagerduty_service.foo", "pagerduty_service.bar"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyServiceDependenciesExists("pagerduty_service_dependencies.foo", 2),
+					resource.TestCheckResourceAttr(
+						"pagerduty_service_dependencies.foo", "supporting_service.#", "2"),
+				),
+			},
+			{
+				Config: testAccCheckPagerDutyServiceDependenciesConfig(businessService, service, username, email, escalationPolicy, "pagerduty_service.bar", "pagerduty_service.baz"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyServiceDependenciesExists("pagerduty_service_dependencies.foo", 2),
+					resource.TestCheckResourceAttr(
+						"pagerduty_service_dependencies.foo", "supporting_service.#", "2"),
+				),
+			},
+			{
+				Config:      testAccCheckPagerDutyServiceDependenciesCycleConfig(businessService, service, username, email, escalationPolicy),
+				ExpectError: regexp.MustCompile("would create a dependency cycle"),
+			},
+		},
+	})
+}
+
+func TestFindServiceDependencyCycle(t *testing.T) {
+	edges := map[string][]string{
+		"B": {"C"},
+		"C": {"D", "E"},
+		"E": {"A"},
+	}
+	supportingOf := func(s *pagerduty.ServiceObj) ([]*pagerduty.ServiceObj, error) {
+		var services []*pagerduty.ServiceObj
+		for _, id := range edges[s.ID] {
+			services = append(services, &pagerduty.ServiceObj{ID: id, Type: "service"})
+		}
+		return services, nil
+	}
+
+	cycle, err := findServiceDependencyCycle(
+		&pagerduty.ServiceObj{ID: "A", Type: "service"},
+		[]*pagerduty.ServiceObj{{ID: "B", Type: "service"}},
+		supportingOf,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"A", "B", "C", "E", "A"}; !reflect.DeepEqual(cycle, want) {
+		t.Errorf("got cycle %v, want %v", cycle, want)
+	}
+
+	cycle, err = findServiceDependencyCycle(
+		&pagerduty.ServiceObj{ID: "X", Type: "service"},
+		[]*pagerduty.ServiceObj{{ID: "B", Type: "service"}},
+		supportingOf,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cycle != nil {
+		t.Errorf("expected no cycle, got %v", cycle)
+	}
+
+	// The supporting services may be shared with the graph of the provider,
+	// and must not be reordered.
+	supporting := []*pagerduty.ServiceObj{{ID: "D", Type: "service"}, {ID: "B", Type: "service"}}
+	if _, err := findServiceDependencyCycle(&pagerduty.ServiceObj{ID: "A", Type: "service"}, supporting, supportingOf); err != nil {
+		t.Fatal(err)
+	}
+	if supporting[0].ID != "D" || supporting[1].ID != "B" {
+		t.Errorf("expected the supporting services to keep their order, got %s, %s", supporting[0].ID, supporting[1].ID)
+	}
+}
+
+func TestDiffSupportingServices(t *testing.T) {
+	have := []*pagerduty.ServiceObj{
+		{ID: "A", Type: "technical_service_reference"},
+		{ID: "B", Type: "technical_service_reference"},
+	}
+	want := []*pagerduty.ServiceObj{
+		{ID: "B", Type: "service"},
+		{ID: "C", Type: "service"},
+	}
+
+	associate, disassociate := diffSupportingServices(have, want)
+
+	if len(associate) != 1 || associate[0].ID != "C" {
+		t.Errorf("expected only C to be associated, got %v", associate)
+	}
+	if len(disassociate) != 1 || disassociate[0].ID != "A" {
+		t.Errorf("expected only A to be disassociated, got %v", disassociate)
+	}
+}
+
+func TestPagerDutyServiceDependenciesDelete_KeepsUnmanaged(t *testing.T) {
+	var associated, disassociated []string
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/service_dependencies/technical_services/PDEP":
+			// C was added outside of Terraform.
+			w.Write([]byte(`{"relationships":[
+				{"supporting_service":{"id":"A","type":"technical_service_reference"},"dependent_service":{"id":"PDEP","type":"technical_service_reference"}},
+				{"supporting_service":{"id":"B","type":"business_service_reference"},"dependent_service":{"id":"PDEP","type":"technical_service_reference"}},
+				{"supporting_service":{"id":"C","type":"technical_service_reference"},"dependent_service":{"id":"PDEP","type":"technical_service_reference"}}
+			]}`))
+		case "/service_dependencies/associate", "/service_dependencies/disassociate":
+			var body pagerduty.ListServiceDependencies
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				t.Error(err)
+			}
+			for _, rel := range body.Relationships {
+				if r.URL.Path == "/service_dependencies/associate" {
+					associated = append(associated, rel.SupportingService.ID)
+				} else {
+					disassociated = append(disassociated, rel.SupportingService.ID)
+				}
+			}
+			w.Write([]byte(`{"relationships":[]}`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	}))
+	defer srv.Close()
+
+	d := schema.TestResourceDataRaw(t, resourcePagerDutyServiceDependencies().Schema, map[string]interface{}{
+		"dependent_service": []interface{}{
+			map[string]interface{}{"id": "PDEP", "type": "service"},
+		},
+		"supporting_service": []interface{}{
+			map[string]interface{}{"id": "A", "type": "service"},
+			map[string]interface{}{"id": "B", "type": "business_service"},
+		},
+	})
+	d.SetId("PDEP")
+
+	config := &Config{Token: "foo", ApiUrlOverride: srv.URL, SkipCredsValidation: true}
+	if err := resourcePagerDutyServiceDependenciesDelete(d, config); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(associated) != 0 {
+		t.Errorf("expected no service to be associated, got %v", associated)
+	}
+	sort.Strings(disassociated)
+	if !reflect.DeepEqual(disassociated, []string{"A", "B"}) {
+		t.Errorf("expected only the managed services A and B to be disassociated, got %v", disassociated)
+	}
+	if d.Id() != "" {
+		t.Errorf("expected the resource to be removed from state, got ID %q", d.Id())
+	}
+}
+
+func testAccCheckPagerDutyServiceDependenciesExists(n string, count int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Service Dependencies ID is set")
+		}
+
+		client, _ := testAccProvider.Meta().(*Config).Client()
+
+		supporting, err := fetchSupportingServices(client, &pagerduty.ServiceObj{
+			ID:   rs.Primary.ID,
+			Type: rs.Primary.Attributes["dependent_service.0.type"],
+		})
+		if err != nil {
+			return err
+		}
+
+		if len(supporting) != count {
+			return fmt.Errorf("Expected %d supporting services, got %d", count, len(supporting))
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckPagerDutyServiceDependenciesDestroy(s *terraform.State) error {
+	client, _ := testAccProvider.Meta().(*Config).Client()
+	for _, r := range s.RootModule().Resources {
+		if r.Type != "pagerduty_service_dependencies" {
+			continue
+		}
+
+		supporting, err := fetchSupportingServices(client, &pagerduty.ServiceObj{
+			ID:   r.Primary.ID,
+			Type: r.Primary.Attributes["dependent_service.0.type"],
+		})
+		if err != nil {
+			// if the dependent service doesn't exist, that's okay
+			continue
+		}
+
+		if len(supporting) > 0 {
+			return fmt.Errorf("supporting services of %s still exist", r.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccCheckPagerDutyServiceDependenciesServicesConfig(businessService, service, username, email, escalationPolicy string) string {
+	return fmt.Sprintf(`
+resource "pagerduty_business_service" "foo" {
+  name = "%[1]s"
+}
+
+resource "pagerduty_user" "foo" {
+  name  = "%[3]s"
+  email = "%[4]s"
+}
+
+resource "pagerduty_escalation_policy" "foo" {
+  name = "%[5]s"
+  rule {
+    escalation_delay_in_minutes = 10
+    target {
+      type = "user_reference"
+      id   = pagerduty_user.foo.id
+    }
+  }
+}
+
+resource "pagerduty_service" "foo" {
+  name              = "%[2]s-foo"
+  escalation_policy = pagerduty_escalation_policy.foo.id
+}
+
+resource "pagerduty_service" "bar" {
+  name              = "%[2]s-bar"
+  escalation_policy = pagerduty_escalation_policy.foo.id
+}
+
+resource "pagerduty_service" "baz" {
+  name              = "%[2]s-baz"
+  escalation_policy = pagerduty_escalation_policy.foo.id
+}
+`, businessService, service, username, email, escalationPolicy)
+}
+
+func testAccCheckPagerDutyServiceDependenciesConfig(businessService, service, username, email, escalationPolicy, first, second string) string {
+	return testAccCheckPagerDutyServiceDependenciesServicesConfig(businessService, service, username, email, escalationPolicy) + fmt.Sprintf(`
+resource "pagerduty_service_dependencies" "foo" {
+  dependent_service {
+    id   = pagerduty_business_service.foo.id
+    type = "business_service"
+  }
+  supporting_service {
+    id   = %s.id
+    type = "service"
+  }
+  supporting_service {
+    id   = %s.id
+    type = "service"
+  }
+}
+`, first, second)
+}
+
+func testAccCheckPagerDutyServiceDependenciesCycleConfig(businessService, service, username, email, escalationPolicy string) string {
+	return testAccCheckPagerDutyServiceDependenciesServicesConfig(businessService, service, username, email, escalationPolicy) + `
+resource "pagerduty_service_dependencies" "bar" {
+  dependent_service {
+    id   = pagerduty_service.bar.id
+    type = "service"
+  }
+  supporting_service {
+    id   = pagerduty_service.baz.id
+    type = "service"
+  }
+}
+
+resource "pagerduty_service_dependencies" "baz" {
+  dependent_service {
+    id   = pagerduty_service.baz.id
+    type = "service"
+  }
+  supporting_service {
+    id   = pagerduty_service.bar.id
+    type = "service"
+  }
+}
+`
+}
@@ -19,6 +19,10 @@ func resourcePagerDutyServiceIntegration() *schema.Resource {
 		Update: resourcePagerDutyServiceIntegrationUpdate,
 		Delete: resourcePagerDutyServiceIntegrationDelete,
 		CustomizeDiff: func(context context.Context, diff *schema.ResourceDiff, i interface{}) error {
+			if err := checkDeletionProtectionDiff(diff, "pagerduty_service_integration", "service", "type", "vendor"); err != nil {
+				return err
+			}
+
 			t := diff.Get("type").(string)
 			if t == "generic_email_inbound_integration" && diff.Get("integration_email").(string) == "" {
 				return fmt.Errorf("integration_email attribute must be set for an integration type generic_email_inbound_integration")
@@ -78,6 +82,7 @@ func resourcePagerDutyServiceIntegration() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"deletion_protection": deletionProtectionSchema(),
 		},
 	}
 }
@@ -192,6 +197,7 @@ func resourcePagerDutyServiceIntegrationCreate(d *schema.ResourceData, meta inte
 
 func resourcePagerDutyServiceIntegrationRead(d *schema.ResourceData, meta interface{}) error {
 	log.Printf("[INFO] Reading PagerDuty service integration %s", d.Id())
+	setDefaultDeletionProtection(d)
 	return fetchPagerDutyServiceIntegration(d, meta, handleNotFoundError)
 }
 
@@ -212,6 +218,10 @@ func resourcePagerDutyServiceIntegrationUpdate(d *schema.ResourceData, meta inte
 }
 
 func resourcePagerDutyServiceIntegrationDelete(d *schema.ResourceData, meta interface{}) error {
+	if err := checkDeletionProtection(d, "pagerduty_service_integration"); err != nil {
+		return err
+	}
+
 	client, _ := meta.(*Config).Client()
 
 	service := d.Get("service").(string)
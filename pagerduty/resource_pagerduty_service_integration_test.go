@@ -105,6 +105,47 @@ func TestAccPagerDutyServiceIntegrationGeneric_Basic(t *testing.T) {
 	})
 }
 
+func TestAccPagerDutyServiceIntegration_DeletionProtection(t *testing.T) {
+	username := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	email := fmt.Sprintf("%s@foo.com", username)
+	escalationPolicy := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	service := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	serviceIntegration := fmt.Sprintf("tf-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPagerDutyServiceIntegrationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckPagerDutyServiceIntegrationDeletionProtectionConfig(username, email, escalationPolicy, service, serviceIntegration, "foo", true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyServiceIntegrationExists("pagerduty_service_integration.foo"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_service_integration.foo", "deletion_protection", "true"),
+				),
+			},
+			{
+				Config:      testAccCheckPagerDutyServiceIntegrationDeletionProtectionConfig(username, email, escalationPolicy, service, serviceIntegration, "bar", true),
+				ExpectError: regexp.MustCompile("changing service would replace it"),
+			},
+			{
+				Config: testAccCheckPagerDutyServiceIntegrationDeletionProtectionConfig(username, email, escalationPolicy, service, serviceIntegration, "foo", false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"pagerduty_service_integration.foo", "deletion_protection", "false"),
+				),
+			},
+			{
+				Config: testAccCheckPagerDutyServiceIntegrationDeletionProtectionConfig(username, email, escalationPolicy, service, serviceIntegration, "bar", false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyServiceIntegrationExists("pagerduty_service_integration.foo"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckPagerDutyServiceIntegrationDestroy(s *terraform.State) error {
 	client, _ := testAccProvider.Meta().(*Config).Client()
 	for _, r := range s.RootModule().Resources {
@@ -385,3 +426,43 @@ resource "pagerduty_service_integration" "foo" {
 }
 `, username, email, escalationPolicy, service, serviceIntegration, integrationEmail)
 }
+
+func testAccCheckPagerDutyServiceIntegrationDeletionProtectionConfig(username, email, escalationPolicy, service, serviceIntegration, integrationService string, deletionProtection bool) string {
+	return fmt.Sprintf(`
+resource "pagerduty_user" "foo" {
+  name  = "%s"
+  email = "%s"
+}
+
+resource "pagerduty_escalation_policy" "foo" {
+  name      = "%s"
+  num_loops = 1
+
+  rule {
+    escalation_delay_in_minutes = 10
+
+    target {
+      type = "user_reference"
+      id   = pagerduty_user.foo.id
+    }
+  }
+}
+
+resource "pagerduty_service" "foo" {
+  name              = "%[4]s-foo"
+  escalation_policy = pagerduty_escalation_policy.foo.id
+}
+
+resource "pagerduty_service" "bar" {
+  name              = "%[4]s-bar"
+  escalation_policy = pagerduty_escalation_policy.foo.id
+}
+
+resource "pagerduty_service_integration" "foo" {
+  name                = "%[5]s"
+  service             = pagerduty_service.%[6]s.id
+  type                = "generic_events_api_inbound_integration"
+  deletion_protection = %[7]t
+}
+`, username, email, escalationPolicy, service, serviceIntegration, integrationService, deletionProtection)
+}
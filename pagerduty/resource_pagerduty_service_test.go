@@ -585,6 +585,41 @@ func TestAccPagerDutyService_SupportHoursChange(t *testing.T) {
 	}
 }
 
+func TestAccPagerDutyService_DeletionProtection(t *testing.T) {
+	username := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	email := fmt.Sprintf("%s@foo.com", username)
+	escalationPolicy := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	service := fmt.Sprintf("tf-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPagerDutyServiceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckPagerDutyServiceConfigDeletionProtection(username, email, escalationPolicy, service, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyServiceExists("pagerduty_service.foo"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_service.foo", "deletion_protection", "true"),
+				),
+			},
+			{
+				Config:      testAccCheckPagerDutyServiceConfigDeletionProtectionRemoved(username, email, escalationPolicy),
+				ExpectError: regexp.MustCompile("has deletion_protection enabled"),
+			},
+			{
+				Config: testAccCheckPagerDutyServiceConfigDeletionProtection(username, email, escalationPolicy, service, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyServiceExists("pagerduty_service.foo"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_service.foo", "deletion_protection", "false"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckPagerDutyServiceSaveServiceId(p *string, n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -1174,3 +1209,36 @@ resource "pagerduty_service" "foo" {
 }
 `, username, email, escalationPolicy, service)
 }
+
+func testAccCheckPagerDutyServiceConfigDeletionProtection(username, email, escalationPolicy, service string, deletionProtection bool) string {
+	return fmt.Sprintf(`
+%s
+
+resource "pagerduty_service" "foo" {
+	name                = "%s"
+	escalation_policy   = pagerduty_escalation_policy.foo.id
+	deletion_protection = %t
+}
+`, testAccCheckPagerDutyServiceConfigDeletionProtectionRemoved(username, email, escalationPolicy), service, deletionProtection)
+}
+
+func testAccCheckPagerDutyServiceConfigDeletionProtectionRemoved(username, email, escalationPolicy string) string {
+	return fmt.Sprintf(`
+resource "pagerduty_user" "foo" {
+	name  = "%s"
+	email = "%s"
+}
+
+resource "pagerduty_escalation_policy" "foo" {
+	name      = "%s"
+	num_loops = 1
+	rule {
+		escalation_delay_in_minutes = 10
+		target {
+			type = "user_reference"
+			id   = pagerduty_user.foo.id
+		}
+	}
+}
+`, username, email, escalationPolicy)
+}
@@ -1,6 +1,7 @@
 package pagerduty
 
 import (
+	"fmt"
 	"log"
 	"time"
 
@@ -36,6 +37,10 @@ func resourcePagerDutyTeam() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"reassign_to_team": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
 		},
 	}
 }
@@ -122,10 +127,17 @@ func resourcePagerDutyTeamUpdate(d *schema.ResourceData, meta interface{}) error
 func resourcePagerDutyTeamDelete(d *schema.ResourceData, meta interface{}) error {
 	client, _ := meta.(*Config).Client()
 
+	reassignTo := d.Get("reassign_to_team").(string)
+	if reassignTo != "" {
+		if err := logTeamReassignments(client, d.Id(), reassignTo); err != nil {
+			return err
+		}
+	}
+
 	log.Printf("[INFO] Deleting PagerDuty team %s", d.Id())
 
 	retryErr := resource.Retry(2*time.Minute, func() *resource.RetryError {
-		if _, err := client.Teams.Delete(d.Id()); err != nil {
+		if _, err := client.Teams.DeleteWithOptions(d.Id(), &pagerduty.DeleteTeamOptions{ReassignmentTeam: reassignTo}); err != nil {
 			return resource.RetryableError(err)
 		}
 		return nil
@@ -140,3 +152,35 @@ func resourcePagerDutyTeamDelete(d *schema.ResourceData, meta interface{}) error
 	time.Sleep(time.Second)
 	return nil
 }
+
+// logTeamReassignments logs the escalation policies and services that
+// PagerDuty moves to the reassignment team when the team is deleted.
+func logTeamReassignments(client *pagerduty.Client, teamID, reassignTo string) error {
+	if teamID == reassignTo {
+		return fmt.Errorf("pagerduty_team %s can't be reassigned to itself", teamID)
+	}
+
+	escalationPolicies, err := client.EscalationPolicies.ListAll(&pagerduty.ListEscalationPoliciesOptions{
+		TeamIDs: []string{teamID},
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, ep := range escalationPolicies {
+		log.Printf("[INFO] Reassigning PagerDuty escalation policy %s from team %s to team %s", ep.ID, teamID, reassignTo)
+	}
+
+	services, err := client.Services.ListAll(&pagerduty.ListServicesOptions{
+		TeamIDs: []string{teamID},
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, s := range services {
+		log.Printf("[INFO] Reassigning PagerDuty service %s from team %s to team %s", s.ID, teamID, reassignTo)
+	}
+
+	return nil
+}
@@ -117,6 +117,99 @@ func TestAccPagerDutyTeam_Parent(t *testing.T) {
 	})
 }
 
+func TestAccPagerDutyTeam_ReassignOnDestroy(t *testing.T) {
+	team := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	reassignTeam := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	escalationPolicy := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	username := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	email := fmt.Sprintf("%s@foo.com", username)
+
+	// The escalation policy is created outside of Terraform so that it is
+	// only owned by the team that is destroyed.
+	var escalationPolicyID string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPagerDutyTeamDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckPagerDutyTeamReassignOnDestroyConfig(team, reassignTeam, username, email),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyTeamExists("pagerduty_team.foo"),
+					resource.TestCheckResourceAttrPair(
+						"pagerduty_team.foo", "reassign_to_team", "pagerduty_team.bar", "id"),
+					testAccCreatePagerDutyTeamEscalationPolicy(escalationPolicy, &escalationPolicyID),
+				),
+			},
+			{
+				Config: testAccCheckPagerDutyTeamReassignOnDestroyConfigRemoved(reassignTeam, username, email),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyTeamReassigned(&escalationPolicyID),
+				),
+			},
+		},
+	})
+}
+
+// testAccCreatePagerDutyTeamEscalationPolicy creates an escalation policy
+// owned by pagerduty_team.foo.
+func testAccCreatePagerDutyTeamEscalationPolicy(name string, id *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client, _ := testAccProvider.Meta().(*Config).Client()
+
+		ep, _, err := client.EscalationPolicies.Create(&pagerduty.EscalationPolicy{
+			Name: name,
+			EscalationRules: []*pagerduty.EscalationRule{
+				{
+					EscalationDelayInMinutes: 10,
+					Targets: []*pagerduty.EscalationTargetReference{
+						{
+							ID:   s.RootModule().Resources["pagerduty_user.foo"].Primary.ID,
+							Type: "user_reference",
+						},
+					},
+				},
+			},
+			Teams: []*pagerduty.TeamReference{
+				{
+					ID:   s.RootModule().Resources["pagerduty_team.foo"].Primary.ID,
+					Type: "team_reference",
+				},
+			},
+		})
+		if err != nil {
+			return err
+		}
+
+		*id = ep.ID
+
+		return nil
+	}
+}
+
+func testAccCheckPagerDutyTeamReassigned(id *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client, _ := testAccProvider.Meta().(*Config).Client()
+
+		ep, _, err := client.EscalationPolicies.Get(*id, &pagerduty.GetEscalationPolicyOptions{})
+		if err != nil {
+			return err
+		}
+
+		want := s.RootModule().Resources["pagerduty_team.bar"].Primary.ID
+		if len(ep.Teams) != 1 || ep.Teams[0].ID != want {
+			return fmt.Errorf("Escalation policy %s was not reassigned to team %s", ep.ID, want)
+		}
+
+		if _, err := client.EscalationPolicies.Delete(ep.ID); err != nil {
+			return err
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckPagerDutyTeamDestroy(s *terraform.State) error {
 	client, _ := testAccProvider.Meta().(*Config).Client()
 	for _, r := range s.RootModule().Resources {
@@ -173,3 +266,34 @@ resource "pagerduty_team" "foo" {
 	parent = pagerduty_team.parent.id
 }`, parent, team)
 }
+
+func testAccCheckPagerDutyTeamReassignOnDestroyConfig(team, reassignTeam, username, email string) string {
+	return fmt.Sprintf(`
+resource "pagerduty_team" "foo" {
+  name             = "%s"
+  reassign_to_team = pagerduty_team.bar.id
+}
+
+resource "pagerduty_team" "bar" {
+  name = "%s"
+}
+
+resource "pagerduty_user" "foo" {
+  name  = "%s"
+  email = "%s"
+}
+`, team, reassignTeam, username, email)
+}
+
+func testAccCheckPagerDutyTeamReassignOnDestroyConfigRemoved(reassignTeam, username, email string) string {
+	return fmt.Sprintf(`
+resource "pagerduty_team" "bar" {
+  name = "%s"
+}
+
+resource "pagerduty_user" "foo" {
+  name  = "%s"
+  email = "%s"
+}
+`, reassignTeam, username, email)
+}
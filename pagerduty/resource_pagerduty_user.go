@@ -98,6 +98,13 @@ func resourcePagerDutyUser() *schema.Resource {
 				Optional: true,
 				Default:  "Managed by Terraform",
 			},
+
+			"detach_on_destroy": detachOnDestroySchema(),
+
+			"reassign_to_user": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
 		},
 	}
 }
@@ -256,6 +263,10 @@ func resourcePagerDutyUserUpdate(d *schema.ResourceData, meta interface{}) error
 func resourcePagerDutyUserDelete(d *schema.ResourceData, meta interface{}) error {
 	client, _ := meta.(*Config).Client()
 
+	if err := detachUser(client, d); err != nil {
+		return err
+	}
+
 	log.Printf("[INFO] Deleting PagerDuty user %s", d.Id())
 
 	// Retrying to give other resources (such as escalation policies) to delete
@@ -280,3 +291,97 @@ func resourcePagerDutyUserDelete(d *schema.ResourceData, meta interface{}) error
 	time.Sleep(time.Second)
 	return nil
 }
+
+// detachUser makes sure the user is no longer referenced by any schedule or
+// escalation policy before it is deleted. The user is replaced by
+// reassign_to_user if it is set, or removed if detach_on_destroy is set.
+// Otherwise it fails with the list of objects that still reference the user.
+func detachUser(client *pagerduty.Client, d *schema.ResourceData) error {
+	reassignTo := d.Get("reassign_to_user").(string)
+	if reassignTo == d.Id() {
+		return fmt.Errorf("pagerduty_user %s can't be reassigned to itself", d.Id())
+	}
+
+	escalationPolicies, err := client.EscalationPolicies.ListAll(&pagerduty.ListEscalationPoliciesOptions{
+		UserIDs: []string{d.Id()},
+	})
+	if err != nil {
+		return err
+	}
+
+	schedules, err := fetchUserSchedules(client, d.Id())
+	if err != nil {
+		return err
+	}
+
+	if len(escalationPolicies) == 0 && len(schedules) == 0 {
+		return nil
+	}
+
+	if reassignTo == "" && !d.Get("detach_on_destroy").(bool) {
+		var dependents []string
+		for _, s := range schedules {
+			dependents = append(dependents, fmt.Sprintf("schedule %q (%s)", s.Name, s.ID))
+		}
+		for _, ep := range escalationPolicies {
+			dependents = append(dependents, fmt.Sprintf("escalation policy %q (%s)", ep.Name, ep.ID))
+		}
+		return fmt.Errorf("pagerduty_user %q (%s) can't be deleted because it is used by:\n%s\nRemove the user from these schedules and escalation policies first, or set reassign_to_user or detach_on_destroy", d.Get("name").(string), d.Id(), formatDependents(dependents))
+	}
+
+	var replacement *pagerduty.EscalationTargetReference
+	if reassignTo != "" {
+		replacement = &pagerduty.EscalationTargetReference{
+			ID:   reassignTo,
+			Type: "user_reference",
+		}
+	}
+
+	// Schedules are handled first since escalation policies may only
+	// reference the user through them.
+	for _, schedule := range schedules {
+		if err := replaceScheduleUser(client, schedule, d.Id(), reassignTo); err != nil {
+			return fmt.Errorf("error detaching pagerduty_user %s: %s", d.Id(), err)
+		}
+	}
+
+	for _, ep := range escalationPolicies {
+		escalationPolicy, _, err := client.EscalationPolicies.Get(ep.ID, &pagerduty.GetEscalationPolicyOptions{})
+		if err != nil {
+			return err
+		}
+		if err := replaceEscalationPolicyTarget(client, escalationPolicy, "user_reference", d.Id(), replacement); err != nil {
+			return fmt.Errorf("error detaching pagerduty_user %s: %s", d.Id(), err)
+		}
+	}
+
+	return nil
+}
+
+// fetchUserSchedules returns the schedules including the user. Schedules
+// can't be listed by user, so every schedule of the account is scanned, and
+// the ones including the user are read in full.
+func fetchUserSchedules(client *pagerduty.Client, userID string) ([]*pagerduty.Schedule, error) {
+	allSchedules, err := client.Schedules.ListAll(&pagerduty.ListSchedulesOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	var schedules []*pagerduty.Schedule
+	for _, s := range allSchedules {
+		for _, u := range s.Users {
+			if u.ID != userID {
+				continue
+			}
+
+			schedule, _, err := client.Schedules.Get(s.ID, &pagerduty.GetScheduleOptions{})
+			if err != nil {
+				return nil, err
+			}
+			schedules = append(schedules, schedule)
+			break
+		}
+	}
+
+	return schedules, nil
+}
@@ -1,14 +1,17 @@
 package pagerduty
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"net/mail"
 	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/heimweh/go-pagerduty/pagerduty"
+	"github.com/terraform-providers/terraform-provider-pagerduty/internal/phonenumber"
 )
 
 func resourcePagerDutyUserContactMethod() *schema.Resource {
@@ -17,6 +20,15 @@ func resourcePagerDutyUserContactMethod() *schema.Resource {
 		Read:   resourcePagerDutyUserContactMethodRead,
 		Update: resourcePagerDutyUserContactMethodUpdate,
 		Delete: resourcePagerDutyUserContactMethodDelete,
+		CustomizeDiff: func(context context.Context, diff *schema.ResourceDiff, i interface{}) error {
+			for _, k := range []string{"type", "address", "country_code"} {
+				if !diff.NewValueKnown(k) {
+					return nil
+				}
+			}
+
+			return validateUserContactMethod(diff.Get("type").(string), diff.Get("address").(string), diff.Get("country_code").(int))
+		},
 		Importer: &schema.ResourceImporter{
 			State: resourcePagerDutyUserContactMethodImport,
 		},
@@ -44,8 +56,9 @@ func resourcePagerDutyUserContactMethod() *schema.Resource {
 			},
 
 			"country_code": {
-				Type:     schema.TypeInt,
-				Optional: true,
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validateCountryCallingCode,
 			},
 
 			"enabled": {
@@ -64,13 +77,62 @@ func resourcePagerDutyUserContactMethod() *schema.Resource {
 			},
 
 			"address": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:             schema.TypeString,
+				Required:         true,
+				DiffSuppressFunc: suppressContactMethodAddressDiff,
 			},
 		},
 	}
 }
 
+func isPhoneContactMethodType(t string) bool {
+	return t == "phone_contact_method" || t == "sms_contact_method"
+}
+
+func validateCountryCallingCode(v interface{}, k string) (we []string, errors []error) {
+	if code := v.(int); !phonenumber.IsCallingCode(code) {
+		errors = append(errors, fmt.Errorf("%d is not a valid country calling code for argument %s", code, k))
+	}
+	return
+}
+
+// validateUserContactMethod checks the address of a contact method against
+// its type. Phone and SMS contact methods need the country calling code of
+// their number, which the other contact methods can't have.
+func validateUserContactMethod(methodType, address string, countryCode int) error {
+	if isPhoneContactMethodType(methodType) {
+		if countryCode == 0 {
+			return fmt.Errorf("country_code is required for %s contact methods", methodType)
+		}
+
+		_, err := phonenumber.Normalize(countryCode, address)
+		return err
+	}
+
+	if countryCode != 0 {
+		return fmt.Errorf("country_code can only be set for phone_contact_method and sms_contact_method contact methods")
+	}
+
+	if methodType == "email_contact_method" {
+		// A parsed address with a display name or comments isn't a bare address.
+		if a, err := mail.ParseAddress(address); err != nil || a.Address != address {
+			return fmt.Errorf("%q is not a valid email address", address)
+		}
+	}
+
+	return nil
+}
+
+// suppressContactMethodAddressDiff ignores the formatting of phone numbers,
+// which PagerDuty stores without it.
+func suppressContactMethodAddressDiff(k, old, new string, d *schema.ResourceData) bool {
+	if !isPhoneContactMethodType(d.Get("type").(string)) {
+		return old == new
+	}
+
+	return phonenumber.Equal(d.Get("country_code").(int), old, new)
+}
+
 func buildUserContactMethodStruct(d *schema.ResourceData) *pagerduty.ContactMethod {
 	contactMethod := &pagerduty.ContactMethod{
 		Type:    d.Get("type").(string),
@@ -78,6 +140,12 @@ func buildUserContactMethodStruct(d *schema.ResourceData) *pagerduty.ContactMeth
 		Address: d.Get("address").(string),
 	}
 
+	if isPhoneContactMethodType(contactMethod.Type) {
+		if address, err := phonenumber.Normalize(d.Get("country_code").(int), contactMethod.Address); err == nil {
+			contactMethod.Address = address
+		}
+	}
+
 	if v, ok := d.GetOk("send_short_email"); ok {
 		contactMethod.SendShortEmail = v.(bool)
 	}
@@ -59,6 +59,11 @@ func TestAccPagerDutyUserContactMethodPhone_Basic(t *testing.T) {
 					testAccCheckPagerDutyUserContactMethodExists("pagerduty_user_contact_method.foo"),
 				),
 			},
+			{
+				// The same number, formatted differently.
+				Config:   testAccCheckPagerDutyUserContactMethodPhoneConfig(usernameUpdated, emailUpdated, "+1 (801) 935-1337"),
+				PlanOnly: true,
+			},
 		},
 	})
 }
@@ -90,6 +95,37 @@ func TestAccPagerDutyUserContactMethodSMS_Basic(t *testing.T) {
 	})
 }
 
+func TestValidateUserContactMethod(t *testing.T) {
+	cases := []struct {
+		methodType  string
+		address     string
+		countryCode int
+		valid       bool
+	}{
+		{"email_contact_method", "foo@example.com", 0, true},
+		{"email_contact_method", "Foo <foo@example.com>", 0, false},
+		{"email_contact_method", "foo@", 0, false},
+		{"email_contact_method", "foo@example.com", 1, false},
+		{"phone_contact_method", "4153013250", 1, true},
+		{"phone_contact_method", "+1 (415) 301-3250", 1, true},
+		{"phone_contact_method", "4153013250", 0, false},
+		{"sms_contact_method", "06 12 34 56 78", 33, true},
+		{"sms_contact_method", "+44 7700 900123", 33, false},
+		{"sms_contact_method", "415301325", 1, false},
+		{"push_notification_contact_method", "device", 1, false},
+	}
+
+	for _, c := range cases {
+		err := validateUserContactMethod(c.methodType, c.address, c.countryCode)
+		if c.valid && err != nil {
+			t.Errorf("expected %s %q (+%d) to be valid, got %v", c.methodType, c.address, c.countryCode, err)
+		}
+		if !c.valid && err == nil {
+			t.Errorf("expected %s %q (+%d) to be invalid", c.methodType, c.address, c.countryCode)
+		}
+	}
+}
+
 func testAccCheckPagerDutyUserContactMethodDestroy(s *terraform.State) error {
 	client, _ := testAccProvider.Meta().(*Config).Client()
 	for _, r := range s.RootModule().Resources {
@@ -0,0 +1,181 @@
+package pagerduty
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/heimweh/go-pagerduty/pagerduty"
+)
+
+func resourcePagerDutyUserHandoffNotificationRule() *schema.Resource {
+	return &schema.Resource{
+		Create: resourcePagerDutyUserHandoffNotificationRuleCreate,
+		Read:   resourcePagerDutyUserHandoffNotificationRuleRead,
+		Update: resourcePagerDutyUserHandoffNotificationRuleUpdate,
+		Delete: resourcePagerDutyUserHandoffNotificationRuleDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourcePagerDutyUserHandoffNotificationRuleImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"user_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"handoff_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "both",
+				ValidateFunc: validateValueFunc([]string{
+					"both",
+					"oncall",
+					"offcall",
+				}),
+			},
+
+			"notify_advance_in_minutes": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+
+			"contact_method": {
+				Required: true,
+				Type:     schema.TypeMap,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				ValidateDiagFunc: validation.MapKeyMatch(regexp.MustCompile("(id|type)"), "`contact_method` must only have `id` and `types` attributes"),
+			},
+		},
+	}
+}
+
+func buildUserHandoffNotificationRuleStruct(d *schema.ResourceData) (*pagerduty.OnCallHandoffNotificationRule, error) {
+	contactMethod, err := expandContactMethod(d.Get("contact_method"))
+	if err != nil {
+		return nil, err
+	}
+
+	rule := &pagerduty.OnCallHandoffNotificationRule{
+		Type:                   "oncall_handoff_notification_rule",
+		HandoffType:            d.Get("handoff_type").(string),
+		NotifyAdvanceInMinutes: d.Get("notify_advance_in_minutes").(int),
+		ContactMethod:          contactMethod,
+	}
+
+	return rule, nil
+}
+
+func fetchPagerDutyUserHandoffNotificationRule(d *schema.ResourceData, meta interface{}, errCallback func(error, *schema.ResourceData) error) error {
+	client, _ := meta.(*Config).Client()
+	userID := d.Get("user_id").(string)
+
+	return resource.Retry(2*time.Minute, func() *resource.RetryError {
+		resp, _, err := client.Users.GetOnCallHandoffNotificationRule(userID, d.Id())
+		if err != nil {
+			errResp := errCallback(err, d)
+			if errResp != nil {
+				time.Sleep(2 * time.Second)
+				return resource.RetryableError(errResp)
+			}
+
+			return nil
+		}
+
+		d.Set("handoff_type", resp.HandoffType)
+		d.Set("notify_advance_in_minutes", resp.NotifyAdvanceInMinutes)
+		d.Set("contact_method", flattenContactMethod(resp.ContactMethod))
+
+		return nil
+	})
+}
+
+func resourcePagerDutyUserHandoffNotificationRuleCreate(d *schema.ResourceData, meta interface{}) error {
+	client, _ := meta.(*Config).Client()
+
+	userID := d.Get("user_id").(string)
+
+	rule, err := buildUserHandoffNotificationRuleStruct(d)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Creating PagerDuty user handoff notification rule for user %s", userID)
+
+	resp, _, err := client.Users.CreateOnCallHandoffNotificationRule(userID, rule)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(resp.ID)
+
+	return fetchPagerDutyUserHandoffNotificationRule(d, meta, genError)
+}
+
+func resourcePagerDutyUserHandoffNotificationRuleRead(d *schema.ResourceData, meta interface{}) error {
+	return fetchPagerDutyUserHandoffNotificationRule(d, meta, handleNotFoundError)
+}
+
+func resourcePagerDutyUserHandoffNotificationRuleUpdate(d *schema.ResourceData, meta interface{}) error {
+	client, _ := meta.(*Config).Client()
+
+	rule, err := buildUserHandoffNotificationRuleStruct(d)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Updating PagerDuty user handoff notification rule %s", d.Id())
+
+	userID := d.Get("user_id").(string)
+
+	if _, _, err := client.Users.UpdateOnCallHandoffNotificationRule(userID, d.Id(), rule); err != nil {
+		return err
+	}
+
+	return resourcePagerDutyUserHandoffNotificationRuleRead(d, meta)
+}
+
+func resourcePagerDutyUserHandoffNotificationRuleDelete(d *schema.ResourceData, meta interface{}) error {
+	client, _ := meta.(*Config).Client()
+
+	log.Printf("[INFO] Deleting PagerDuty user handoff notification rule %s", d.Id())
+
+	userID := d.Get("user_id").(string)
+
+	if _, err := client.Users.DeleteOnCallHandoffNotificationRule(userID, d.Id()); err != nil {
+		return handleNotFoundError(err, d)
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func resourcePagerDutyUserHandoffNotificationRuleImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	client, _ := meta.(*Config).Client()
+
+	ids := strings.Split(d.Id(), ":")
+
+	if len(ids) != 2 {
+		return []*schema.ResourceData{}, fmt.Errorf("Error importing pagerduty_user_handoff_notification_rule. Expecting an ID formed as '<user_id>:<handoff_notification_rule_id>'")
+	}
+	uid, id := ids[0], ids[1]
+
+	_, _, err := client.Users.GetOnCallHandoffNotificationRule(uid, id)
+	if err != nil {
+		return []*schema.ResourceData{}, err
+	}
+
+	d.SetId(id)
+	d.Set("user_id", uid)
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,653 @@
+package pagerduty
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/heimweh/go-pagerduty/pagerduty"
+	"github.com/terraform-providers/terraform-provider-pagerduty/internal/phonenumber"
+)
+
+var (
+	notificationProfileKeyRegexp = regexp.MustCompile(`^[a-z][a-z0-9_]*$`)
+	pagerDutyIDRegexp            = regexp.MustCompile(`^P[A-Z0-9]+$`)
+
+	notificationProfileRuleAttributes = []string{"high_urgency_rule", "low_urgency_rule"}
+)
+
+// resourcePagerDutyUserNotificationProfile manages all the contact methods
+// and notification rules of a user at once. The contact methods and rules
+// the profile doesn't declare are either removed or adopted, according to
+// `mode`. The login email and the push notification contact methods of the
+// user, which the API can't create, are never removed.
+func resourcePagerDutyUserNotificationProfile() *schema.Resource {
+	return &schema.Resource{
+		Create: resourcePagerDutyUserNotificationProfileCreate,
+		Read:   resourcePagerDutyUserNotificationProfileRead,
+		Update: resourcePagerDutyUserNotificationProfileUpdate,
+		Delete: resourcePagerDutyUserNotificationProfileDelete,
+		CustomizeDiff: func(context context.Context, diff *schema.ResourceDiff, i interface{}) error {
+			if err := validateUserNotificationProfile(diff); err != nil {
+				return err
+			}
+
+			if diff.Get("mode").(string) == "remove" {
+				for _, k := range []string{"extra_contact_method_ids", "extra_notification_rule_ids"} {
+					if len(diff.Get(k).([]interface{})) > 0 {
+						if err := diff.SetNew(k, []string{}); err != nil {
+							return err
+						}
+					}
+				}
+			}
+
+			return nil
+		},
+		Importer: &schema.ResourceImporter{
+			State: resourcePagerDutyUserNotificationProfileImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"user_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"mode": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "adopt",
+				ValidateFunc: validateValueFunc([]string{
+					"adopt",
+					"remove",
+				}),
+			},
+			"contact_method": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringMatch(notificationProfileKeyRegexp, "must start with a lowercase letter and only contain lowercase letters, digits and underscores"),
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validateValueFunc([]string{
+								"email_contact_method",
+								"phone_contact_method",
+								"sms_contact_method",
+							}),
+						},
+						"address": {
+							Type:             schema.TypeString,
+							Required:         true,
+							DiffSuppressFunc: suppressNotificationProfileAddressDiff,
+						},
+						"label": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"country_code": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validateCountryCallingCode,
+						},
+						"send_short_email": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"high_urgency_rule": notificationProfileRuleSchema(),
+			"low_urgency_rule":  notificationProfileRuleSchema(),
+			"extra_contact_method_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"extra_notification_rule_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func notificationProfileRuleSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"contact_method": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				"start_delay_in_minutes": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Default:      0,
+					ValidateFunc: validation.IntAtLeast(0),
+				},
+				"id": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+// validateUserNotificationProfile checks that the contact method keys are
+// unique and that the rules reference either one of them or the ID of an
+// existing contact method.
+func validateUserNotificationProfile(diff *schema.ResourceDiff) error {
+	keys := make(map[string]bool)
+
+	for i, v := range diff.Get("contact_method").([]interface{}) {
+		cm := v.(map[string]interface{})
+		key := cm["key"].(string)
+		if key == "" {
+			continue
+		}
+		if keys[key] {
+			return fmt.Errorf("contact_method key %q is used more than once", key)
+		}
+		keys[key] = true
+
+		prefix := fmt.Sprintf("contact_method.%d.", i)
+		if !diff.NewValueKnown(prefix+"type") || !diff.NewValueKnown(prefix+"address") || !diff.NewValueKnown(prefix+"country_code") {
+			continue
+		}
+		if err := validateUserContactMethod(cm["type"].(string), cm["address"].(string), cm["country_code"].(int)); err != nil {
+			return fmt.Errorf("contact_method %q: %v", key, err)
+		}
+	}
+
+	for _, attr := range notificationProfileRuleAttributes {
+		for i, v := range diff.Get(attr).([]interface{}) {
+			if !diff.NewValueKnown(fmt.Sprintf("%s.%d.contact_method", attr, i)) {
+				continue
+			}
+
+			ref := v.(map[string]interface{})["contact_method"].(string)
+			if !keys[ref] && !pagerDutyIDRegexp.MatchString(ref) {
+				return fmt.Errorf("%s contact_method %q is neither the key of a contact_method nor the ID of a contact method", attr, ref)
+			}
+		}
+	}
+
+	return nil
+}
+
+// suppressNotificationProfileAddressDiff ignores the formatting of the phone
+// numbers of the contact_method blocks.
+func suppressNotificationProfileAddressDiff(k, old, new string, d *schema.ResourceData) bool {
+	prefix := strings.TrimSuffix(k, "address")
+
+	if !isPhoneContactMethodType(d.Get(prefix + "type").(string)) {
+		return old == new
+	}
+
+	return phonenumber.Equal(d.Get(prefix+"country_code").(int), old, new)
+}
+
+// isUnmanagedContactMethod reports whether a contact method can't be created
+// through the API, so that the profile never removes it.
+func isUnmanagedContactMethod(user *pagerduty.FullUser, cm *pagerduty.ContactMethod) bool {
+	return cm.Type == "push_notification_contact_method" ||
+		(cm.Type == "email_contact_method" && strings.EqualFold(cm.Address, user.Email))
+}
+
+func buildNotificationProfileContactMethod(v map[string]interface{}) *pagerduty.ContactMethod {
+	cm := &pagerduty.ContactMethod{
+		Type:           v["type"].(string),
+		Label:          v["label"].(string),
+		Address:        v["address"].(string),
+		CountryCode:    v["country_code"].(int),
+		SendShortEmail: v["send_short_email"].(bool),
+	}
+
+	if isPhoneContactMethodType(cm.Type) {
+		if address, err := phonenumber.Normalize(cm.CountryCode, cm.Address); err == nil {
+			cm.Address = address
+		}
+	}
+
+	return cm
+}
+
+func sameContactMethodAddress(a, b *pagerduty.ContactMethod) bool {
+	if a.Type != b.Type {
+		return false
+	}
+	if isPhoneContactMethodType(a.Type) {
+		return a.CountryCode == b.CountryCode && phonenumber.Equal(a.CountryCode, a.Address, b.Address)
+	}
+	return strings.EqualFold(a.Address, b.Address)
+}
+
+func contactMethodNeedsUpdate(current, desired *pagerduty.ContactMethod) bool {
+	return !sameContactMethodAddress(current, desired) ||
+		current.Label != desired.Label ||
+		current.SendShortEmail != desired.SendShortEmail
+}
+
+func fetchPagerDutyUserNotificationProfile(d *schema.ResourceData, meta interface{}) (*pagerduty.FullUser, error) {
+	client, _ := meta.(*Config).Client()
+
+	var user *pagerduty.FullUser
+
+	retryErr := resource.Retry(2*time.Minute, func() *resource.RetryError {
+		var err error
+		if user, _, err = client.Users.GetFull(d.Get("user_id").(string)); err != nil {
+			if isErrCode(err, 404) {
+				return resource.NonRetryableError(err)
+			}
+
+			time.Sleep(2 * time.Second)
+			return resource.RetryableError(err)
+		}
+
+		return nil
+	})
+
+	return user, retryErr
+}
+
+// applyUserNotificationProfile makes the contact methods and notification
+// rules of the user match the profile. Declared contact methods and rules
+// without an ID take over an identical existing one before a new one is
+// created, so that applying a profile to a configured user doesn't fail on
+// duplicates.
+func applyUserNotificationProfile(d *schema.ResourceData, meta interface{}) error {
+	client, _ := meta.(*Config).Client()
+	userID := d.Get("user_id").(string)
+
+	user, err := fetchPagerDutyUserNotificationProfile(d, meta)
+	if err != nil {
+		return err
+	}
+
+	existingCMs := make(map[string]*pagerduty.ContactMethod)
+	for _, cm := range user.ContactMethods {
+		existingCMs[cm.ID] = cm
+	}
+	existingRules := make(map[string]*pagerduty.NotificationRule)
+	for _, r := range user.NotificationRules {
+		existingRules[r.ID] = r
+	}
+
+	claimedCMs := make(map[string]bool)
+	claimedRules := make(map[string]bool)
+	keyToID := make(map[string]string)
+
+	contactMethods := d.Get("contact_method").([]interface{})
+	for _, v := range contactMethods {
+		block := v.(map[string]interface{})
+		desired := buildNotificationProfileContactMethod(block)
+
+		current := existingCMs[block["id"].(string)]
+		if current != nil && (claimedCMs[current.ID] || current.Type != desired.Type) {
+			current = nil
+		}
+		if current == nil {
+			for _, cm := range user.ContactMethods {
+				if !claimedCMs[cm.ID] && sameContactMethodAddress(cm, desired) {
+					current = cm
+					break
+				}
+			}
+		}
+
+		switch {
+		case current == nil:
+			log.Printf("[INFO] Creating PagerDuty contact method %s of user %s", block["key"], userID)
+
+			cm, _, err := client.Users.CreateContactMethod(userID, desired)
+			if err != nil {
+				return err
+			}
+			current = cm
+		case contactMethodNeedsUpdate(current, desired):
+			log.Printf("[INFO] Updating PagerDuty contact method %s of user %s", current.ID, userID)
+
+			if _, _, err := client.Users.UpdateContactMethod(userID, current.ID, desired); err != nil {
+				return err
+			}
+		}
+
+		claimedCMs[current.ID] = true
+		keyToID[block["key"].(string)] = current.ID
+		block["id"] = current.ID
+		// The type of a contact method is needed to reference it from rules.
+		existingCMs[current.ID] = &pagerduty.ContactMethod{ID: current.ID, Type: desired.Type}
+	}
+
+	rules := make(map[string][]interface{})
+	for _, attr := range notificationProfileRuleAttributes {
+		urgency := strings.TrimSuffix(attr, "_urgency_rule")
+		rules[attr] = d.Get(attr).([]interface{})
+
+		for _, v := range rules[attr] {
+			block := v.(map[string]interface{})
+
+			cmID := block["contact_method"].(string)
+			if id, ok := keyToID[cmID]; ok {
+				cmID = id
+			}
+			cm, ok := existingCMs[cmID]
+			if !ok {
+				return fmt.Errorf("%s contact_method %q is not a contact method of user %s", attr, block["contact_method"], userID)
+			}
+			// Contact methods referenced by ID are kept even in remove mode.
+			claimedCMs[cmID] = true
+
+			desired := &pagerduty.NotificationRule{
+				Type:                "assignment_notification_rule",
+				StartDelayInMinutes: block["start_delay_in_minutes"].(int),
+				Urgency:             urgency,
+				ContactMethod:       &pagerduty.ContactMethodReference{ID: cmID, Type: cm.Type},
+			}
+
+			current := existingRules[block["id"].(string)]
+			if current != nil && claimedRules[current.ID] {
+				current = nil
+			}
+			if current == nil {
+				for _, r := range user.NotificationRules {
+					if !claimedRules[r.ID] && r.Urgency == urgency && r.StartDelayInMinutes == desired.StartDelayInMinutes && r.ContactMethod != nil && r.ContactMethod.ID == cmID {
+						current = r
+						break
+					}
+				}
+			}
+
+			switch {
+			case current == nil:
+				log.Printf("[INFO] Creating PagerDuty %s urgency notification rule of user %s", urgency, userID)
+
+				r, _, err := client.Users.CreateNotificationRule(userID, desired)
+				if err != nil {
+					return err
+				}
+				current = r
+			case current.Urgency != urgency || current.StartDelayInMinutes != desired.StartDelayInMinutes || current.ContactMethod == nil || current.ContactMethod.ID != cmID:
+				log.Printf("[INFO] Updating PagerDuty notification rule %s of user %s", current.ID, userID)
+
+				if _, _, err := client.Users.UpdateNotificationRule(userID, current.ID, desired); err != nil {
+					return err
+				}
+			}
+
+			claimedRules[current.ID] = true
+			block["id"] = current.ID
+		}
+	}
+
+	// Rules go first, as deleting a contact method deletes its rules.
+	var extraRules, extraCMs []string
+	removeExtras := d.Get("mode").(string) == "remove"
+
+	for _, r := range user.NotificationRules {
+		if claimedRules[r.ID] {
+			continue
+		}
+		if !removeExtras && !wasManagedByNotificationProfile(d, r.ID) {
+			extraRules = append(extraRules, r.ID)
+			continue
+		}
+
+		log.Printf("[INFO] Deleting PagerDuty notification rule %s of user %s", r.ID, userID)
+
+		if _, err := client.Users.DeleteNotificationRule(userID, r.ID); err != nil && !isErrCode(err, 404) {
+			return err
+		}
+	}
+
+	for _, cm := range user.ContactMethods {
+		if claimedCMs[cm.ID] || isUnmanagedContactMethod(user, cm) {
+			continue
+		}
+		if !removeExtras && !wasManagedByNotificationProfile(d, cm.ID) {
+			extraCMs = append(extraCMs, cm.ID)
+			continue
+		}
+
+		log.Printf("[INFO] Deleting PagerDuty contact method %s of user %s", cm.ID, userID)
+
+		if _, err := client.Users.DeleteContactMethod(userID, cm.ID); err != nil && !isErrCode(err, 404) {
+			return err
+		}
+	}
+
+	d.Set("contact_method", contactMethods)
+	for _, attr := range notificationProfileRuleAttributes {
+		d.Set(attr, rules[attr])
+	}
+	d.Set("extra_contact_method_ids", extraCMs)
+	d.Set("extra_notification_rule_ids", extraRules)
+
+	return nil
+}
+
+// wasManagedByNotificationProfile reports whether a contact method or rule
+// was declared by the profile before the current apply, which removes it
+// whatever the mode.
+func wasManagedByNotificationProfile(d *schema.ResourceData, id string) bool {
+	for _, attr := range []string{"contact_method", "high_urgency_rule", "low_urgency_rule"} {
+		old, _ := d.GetChange(attr)
+		for _, v := range old.([]interface{}) {
+			if v.(map[string]interface{})["id"].(string) == id {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func resourcePagerDutyUserNotificationProfileCreate(d *schema.ResourceData, meta interface{}) error {
+	d.SetId(d.Get("user_id").(string))
+
+	log.Printf("[INFO] Creating PagerDuty notification profile of user %s", d.Id())
+
+	if err := applyUserNotificationProfile(d, meta); err != nil {
+		return err
+	}
+
+	return resourcePagerDutyUserNotificationProfileRead(d, meta)
+}
+
+func resourcePagerDutyUserNotificationProfileRead(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[INFO] Reading PagerDuty notification profile of user %s", d.Id())
+
+	user, err := fetchPagerDutyUserNotificationProfile(d, meta)
+	if err != nil {
+		return handleNotFoundError(err, d)
+	}
+
+	cms := make(map[string]*pagerduty.ContactMethod)
+	for _, cm := range user.ContactMethods {
+		cms[cm.ID] = cm
+	}
+	rules := make(map[string]*pagerduty.NotificationRule)
+	for _, r := range user.NotificationRules {
+		rules[r.ID] = r
+	}
+
+	managed := make(map[string]bool)
+	idToKey := make(map[string]string)
+
+	// Declared contact methods and rules which were deleted in the app are
+	// dropped, so that they are created again.
+	var contactMethods []map[string]interface{}
+	for _, v := range d.Get("contact_method").([]interface{}) {
+		block := v.(map[string]interface{})
+		cm, ok := cms[block["id"].(string)]
+		if !ok {
+			continue
+		}
+
+		managed[cm.ID] = true
+		idToKey[cm.ID] = block["key"].(string)
+
+		contactMethods = append(contactMethods, map[string]interface{}{
+			"key":              block["key"],
+			"type":             cm.Type,
+			"address":          cm.Address,
+			"label":            cm.Label,
+			"country_code":     cm.CountryCode,
+			"send_short_email": cm.SendShortEmail,
+			"id":               cm.ID,
+		})
+	}
+	d.Set("contact_method", contactMethods)
+
+	for _, attr := range notificationProfileRuleAttributes {
+		var flattened []map[string]interface{}
+		for _, v := range d.Get(attr).([]interface{}) {
+			r, ok := rules[v.(map[string]interface{})["id"].(string)]
+			if !ok || r.ContactMethod == nil {
+				continue
+			}
+
+			managed[r.ID] = true
+			managed[r.ContactMethod.ID] = true
+
+			ref := r.ContactMethod.ID
+			if key, ok := idToKey[ref]; ok {
+				ref = key
+			}
+
+			flattened = append(flattened, map[string]interface{}{
+				"contact_method":         ref,
+				"start_delay_in_minutes": r.StartDelayInMinutes,
+				"id":                     r.ID,
+			})
+		}
+		d.Set(attr, flattened)
+	}
+
+	var extraCMs, extraRules []string
+	for _, cm := range user.ContactMethods {
+		if !managed[cm.ID] && !isUnmanagedContactMethod(user, cm) {
+			extraCMs = append(extraCMs, cm.ID)
+		}
+	}
+	for _, r := range user.NotificationRules {
+		if !managed[r.ID] {
+			extraRules = append(extraRules, r.ID)
+		}
+	}
+	d.Set("extra_contact_method_ids", extraCMs)
+	d.Set("extra_notification_rule_ids", extraRules)
+
+	return nil
+}
+
+func resourcePagerDutyUserNotificationProfileUpdate(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[INFO] Updating PagerDuty notification profile of user %s", d.Id())
+
+	if err := applyUserNotificationProfile(d, meta); err != nil {
+		return err
+	}
+
+	return resourcePagerDutyUserNotificationProfileRead(d, meta)
+}
+
+func resourcePagerDutyUserNotificationProfileDelete(d *schema.ResourceData, meta interface{}) error {
+	client, _ := meta.(*Config).Client()
+	userID := d.Get("user_id").(string)
+
+	log.Printf("[INFO] Deleting PagerDuty notification profile of user %s", userID)
+
+	user, err := fetchPagerDutyUserNotificationProfile(d, meta)
+	if err != nil {
+		if isErrCode(err, 404) {
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	// Only the contact methods and rules declared by the profile are
+	// deleted, unless the profile removes every other one as well.
+	removeExtras := d.Get("mode").(string) == "remove"
+
+	var ruleIDs, cmIDs []string
+	for _, attr := range notificationProfileRuleAttributes {
+		for _, v := range d.Get(attr).([]interface{}) {
+			ruleIDs = append(ruleIDs, v.(map[string]interface{})["id"].(string))
+		}
+	}
+	for _, v := range d.Get("contact_method").([]interface{}) {
+		cmIDs = append(cmIDs, v.(map[string]interface{})["id"].(string))
+	}
+
+	declared := make(map[string]bool)
+	for _, id := range append(ruleIDs, cmIDs...) {
+		declared[id] = true
+	}
+
+	if removeExtras {
+		for _, r := range user.NotificationRules {
+			if !declared[r.ID] {
+				ruleIDs = append(ruleIDs, r.ID)
+			}
+		}
+	}
+
+	for _, id := range ruleIDs {
+		if id == "" {
+			continue
+		}
+		if _, err := client.Users.DeleteNotificationRule(userID, id); err != nil && !isErrCode(err, 404) {
+			return err
+		}
+	}
+
+	unmanaged := make(map[string]bool)
+	for _, cm := range user.ContactMethods {
+		if isUnmanagedContactMethod(user, cm) {
+			unmanaged[cm.ID] = true
+		} else if removeExtras && !declared[cm.ID] {
+			cmIDs = append(cmIDs, cm.ID)
+		}
+	}
+
+	for _, id := range cmIDs {
+		if id == "" || unmanaged[id] {
+			continue
+		}
+		if _, err := client.Users.DeleteContactMethod(userID, id); err != nil && !isErrCode(err, 404) {
+			return err
+		}
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func resourcePagerDutyUserNotificationProfileImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.Set("user_id", d.Id())
+	d.Set("mode", "adopt")
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,370 @@
+package pagerduty
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/heimweh/go-pagerduty/pagerduty"
+)
+
+func TestPagerDutyUserNotificationProfile_Apply(t *testing.T) {
+	user := &pagerduty.FullUser{
+		ID:    "PUSER",
+		Email: "jane@foo.com",
+		ContactMethods: []*pagerduty.ContactMethod{
+			{ID: "PEMAIL", Type: "email_contact_method", Address: "jane@foo.com", Label: "Default"},
+			{ID: "PPUSH", Type: "push_notification_contact_method", Address: "token", Label: "Phone"},
+			{ID: "PPHONE", Type: "phone_contact_method", Address: "4153013250", CountryCode: 1, Label: "Mobile"},
+			{ID: "PSMS", Type: "sms_contact_method", Address: "4153013251", CountryCode: 1, Label: "Old"},
+		},
+		NotificationRules: []*pagerduty.NotificationRule{
+			{ID: "PRULE1", Urgency: "high", ContactMethod: &pagerduty.ContactMethodReference{ID: "PPHONE", Type: "phone_contact_method"}},
+			{ID: "PRULE2", Urgency: "low", StartDelayInMinutes: 5, ContactMethod: &pagerduty.ContactMethodReference{ID: "PSMS", Type: "sms_contact_method"}},
+		},
+	}
+
+	var createdCMs []*pagerduty.ContactMethod
+	var createdRules []*pagerduty.NotificationRule
+	var updated, deleted []string
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/users/PUSER":
+			json.NewEncoder(w).Encode(&pagerduty.FullUserPayload{User: user})
+		case r.Method == "POST" && r.URL.Path == "/users/PUSER/contact_methods":
+			var v pagerduty.ContactMethodPayload
+			if err := json.NewDecoder(r.Body).Decode(&v); err != nil {
+				t.Fatal(err)
+			}
+			v.ContactMethod.ID = fmt.Sprintf("PNEWCM%d", len(createdCMs))
+			createdCMs = append(createdCMs, v.ContactMethod)
+			w.WriteHeader(http.StatusCreated)
+			json.NewEncoder(w).Encode(v)
+		case r.Method == "POST" && r.URL.Path == "/users/PUSER/notification_rules":
+			var v pagerduty.NotificationRulePayload
+			if err := json.NewDecoder(r.Body).Decode(&v); err != nil {
+				t.Fatal(err)
+			}
+			v.NotificationRule.ID = fmt.Sprintf("PNEWRULE%d", len(createdRules))
+			createdRules = append(createdRules, v.NotificationRule)
+			w.WriteHeader(http.StatusCreated)
+			json.NewEncoder(w).Encode(v)
+		case r.Method == "PUT":
+			updated = append(updated, r.URL.Path)
+			fmt.Fprint(w, `{}`)
+		case r.Method == "DELETE":
+			deleted = append(deleted, r.URL.Path)
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	}))
+	defer srv.Close()
+
+	d := schema.TestResourceDataRaw(t, resourcePagerDutyUserNotificationProfile().Schema, map[string]interface{}{
+		"user_id": "PUSER",
+		"mode":    "remove",
+		"contact_method": []interface{}{
+			map[string]interface{}{
+				"key":          "mobile",
+				"type":         "phone_contact_method",
+				"address":      "+1 (415) 301-3250",
+				"country_code": 1,
+				"label":        "Mobile",
+			},
+			map[string]interface{}{
+				"key":     "work",
+				"type":    "email_contact_method",
+				"address": "jane.work@foo.com",
+				"label":   "Work",
+			},
+		},
+		"high_urgency_rule": []interface{}{
+			map[string]interface{}{"contact_method": "mobile"},
+			map[string]interface{}{"contact_method": "work", "start_delay_in_minutes": 5},
+		},
+		"low_urgency_rule": []interface{}{
+			map[string]interface{}{"contact_method": "PEMAIL"},
+		},
+	})
+	d.SetId("PUSER")
+
+	config := &Config{Token: "foo", ApiUrlOverride: srv.URL, SkipCredsValidation: true}
+	if err := applyUserNotificationProfile(d, config); err != nil {
+		t.Fatal(err)
+	}
+
+	// The phone contact method and its rule are adopted as they are.
+	if len(updated) != 0 {
+		t.Errorf("expected nothing to be updated, got %v", updated)
+	}
+
+	if len(createdCMs) != 1 || createdCMs[0].Address != "jane.work@foo.com" || createdCMs[0].Label != "Work" {
+		t.Errorf("expected only the work email to be created, got %v", createdCMs)
+	}
+
+	if len(createdRules) != 2 {
+		t.Fatalf("expected 2 rules to be created, got %d", len(createdRules))
+	}
+	if r := createdRules[0]; r.Urgency != "high" || r.StartDelayInMinutes != 5 || r.ContactMethod.ID != "PNEWCM0" || r.ContactMethod.Type != "email_contact_method" {
+		t.Errorf("unexpected high urgency rule %+v", r)
+	}
+	if r := createdRules[1]; r.Urgency != "low" || r.ContactMethod.ID != "PEMAIL" || r.ContactMethod.Type != "email_contact_method" {
+		t.Errorf("unexpected low urgency rule %+v", r)
+	}
+
+	// The login email and the push contact method are never removed.
+	sort.Strings(deleted)
+	if strings.Join(deleted, ",") != "/users/PUSER/contact_methods/PSMS,/users/PUSER/notification_rules/PRULE2" {
+		t.Errorf("unexpected deletions %v", deleted)
+	}
+
+	if id := d.Get("contact_method.0.id").(string); id != "PPHONE" {
+		t.Errorf("expected the phone contact method to be adopted, got %q", id)
+	}
+	if id := d.Get("high_urgency_rule.0.id").(string); id != "PRULE1" {
+		t.Errorf("expected the phone rule to be adopted, got %q", id)
+	}
+	if n := len(d.Get("extra_contact_method_ids").([]interface{})); n != 0 {
+		t.Errorf("expected no extra contact methods, got %d", n)
+	}
+}
+
+func TestPagerDutyUserNotificationProfile_Adopt(t *testing.T) {
+	user := &pagerduty.FullUser{
+		ID:    "PUSER",
+		Email: "jane@foo.com",
+		ContactMethods: []*pagerduty.ContactMethod{
+			{ID: "PEMAIL", Type: "email_contact_method", Address: "jane@foo.com", Label: "Default"},
+			{ID: "PSMS", Type: "sms_contact_method", Address: "4153013251", CountryCode: 1, Label: "Old"},
+		},
+		NotificationRules: []*pagerduty.NotificationRule{
+			{ID: "PRULE", Urgency: "low", ContactMethod: &pagerduty.ContactMethodReference{ID: "PSMS", Type: "sms_contact_method"}},
+		},
+	}
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "GET" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		json.NewEncoder(w).Encode(&pagerduty.FullUserPayload{User: user})
+	}))
+	defer srv.Close()
+
+	d := schema.TestResourceDataRaw(t, resourcePagerDutyUserNotificationProfile().Schema, map[string]interface{}{
+		"user_id": "PUSER",
+		"low_urgency_rule": []interface{}{
+			map[string]interface{}{"contact_method": "PSMS"},
+		},
+	})
+	d.SetId("PUSER")
+
+	config := &Config{Token: "foo", ApiUrlOverride: srv.URL, SkipCredsValidation: true}
+	if err := applyUserNotificationProfile(d, config); err != nil {
+		t.Fatal(err)
+	}
+
+	if id := d.Get("low_urgency_rule.0.id").(string); id != "PRULE" {
+		t.Errorf("expected the rule to be adopted, got %q", id)
+	}
+	// Contact methods referenced by ID aren't extras.
+	if ids := d.Get("extra_contact_method_ids").([]interface{}); len(ids) != 0 {
+		t.Errorf("expected no extra contact methods, got %v", ids)
+	}
+}
+
+func TestPagerDutyUserNotificationProfile_Delete(t *testing.T) {
+	user := &pagerduty.FullUser{
+		ID:    "PUSER",
+		Email: "jane@foo.com",
+		ContactMethods: []*pagerduty.ContactMethod{
+			{ID: "PEMAIL", Type: "email_contact_method", Address: "jane@foo.com", Label: "Default"},
+			{ID: "PPUSH", Type: "push_notification_contact_method", Address: "token", Label: "Phone"},
+			{ID: "PPHONE", Type: "phone_contact_method", Address: "4153013250", CountryCode: 1, Label: "Mobile"},
+			{ID: "PSMS", Type: "sms_contact_method", Address: "4153013251", CountryCode: 1, Label: "Old"},
+		},
+		NotificationRules: []*pagerduty.NotificationRule{
+			{ID: "PRULE1", Urgency: "high", ContactMethod: &pagerduty.ContactMethodReference{ID: "PPHONE", Type: "phone_contact_method"}},
+			{ID: "PRULE2", Urgency: "low", ContactMethod: &pagerduty.ContactMethodReference{ID: "PSMS", Type: "sms_contact_method"}},
+		},
+	}
+
+	cases := map[string]string{
+		"adopt":  "/users/PUSER/contact_methods/PPHONE,/users/PUSER/notification_rules/PRULE1",
+		"remove": "/users/PUSER/contact_methods/PPHONE,/users/PUSER/contact_methods/PSMS,/users/PUSER/notification_rules/PRULE1,/users/PUSER/notification_rules/PRULE2",
+	}
+
+	for mode, want := range cases {
+		var deleted []string
+
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			switch r.Method {
+			case "GET":
+				json.NewEncoder(w).Encode(&pagerduty.FullUserPayload{User: user})
+			case "DELETE":
+				deleted = append(deleted, r.URL.Path)
+				w.WriteHeader(http.StatusNoContent)
+			default:
+				t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+				w.WriteHeader(http.StatusBadRequest)
+			}
+		}))
+
+		d := schema.TestResourceDataRaw(t, resourcePagerDutyUserNotificationProfile().Schema, map[string]interface{}{
+			"user_id": "PUSER",
+			"mode":    mode,
+		})
+		d.SetId("PUSER")
+		d.Set("contact_method", []interface{}{
+			map[string]interface{}{"key": "mobile", "type": "phone_contact_method", "address": "4153013250", "country_code": 1, "label": "Mobile", "id": "PPHONE"},
+		})
+		d.Set("high_urgency_rule", []interface{}{
+			map[string]interface{}{"contact_method": "mobile", "id": "PRULE1"},
+		})
+		d.Set("extra_contact_method_ids", []string{"PSMS"})
+		d.Set("extra_notification_rule_ids", []string{"PRULE2"})
+
+		config := &Config{Token: "foo", ApiUrlOverride: srv.URL, SkipCredsValidation: true}
+		if err := resourcePagerDutyUserNotificationProfileDelete(d, config); err != nil {
+			t.Fatal(err)
+		}
+		srv.Close()
+
+		// The login email and the push contact method are never removed.
+		sort.Strings(deleted)
+		if got := strings.Join(deleted, ","); got != want {
+			t.Errorf("%s: expected deletions %s, got %s", mode, want, got)
+		}
+	}
+}
+
+func TestAccPagerDutyUserNotificationProfile_Basic(t *testing.T) {
+	username := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	email := fmt.Sprintf("%s@foo.com", username)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPagerDutyUserNotificationProfileDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckPagerDutyUserNotificationProfileConfig(username, email, "unknown", 0),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`neither the key of a contact_method`),
+			},
+			{
+				Config: testAccCheckPagerDutyUserNotificationProfileConfig(username, email, "mobile", 0),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyUserNotificationProfileExists("pagerduty_user_notification_profile.foo"),
+					resource.TestCheckResourceAttr("pagerduty_user_notification_profile.foo", "contact_method.#", "2"),
+					resource.TestCheckResourceAttr("pagerduty_user_notification_profile.foo", "high_urgency_rule.0.contact_method", "mobile"),
+					resource.TestCheckResourceAttr("pagerduty_user_notification_profile.foo", "extra_contact_method_ids.#", "0"),
+				),
+			},
+			{
+				Config: testAccCheckPagerDutyUserNotificationProfileConfig(username, email, "work", 10),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyUserNotificationProfileExists("pagerduty_user_notification_profile.foo"),
+					resource.TestCheckResourceAttr("pagerduty_user_notification_profile.foo", "high_urgency_rule.0.contact_method", "work"),
+					resource.TestCheckResourceAttr("pagerduty_user_notification_profile.foo", "high_urgency_rule.0.start_delay_in_minutes", "10"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckPagerDutyUserNotificationProfileDestroy(s *terraform.State) error {
+	client, _ := testAccProvider.Meta().(*Config).Client()
+	for _, r := range s.RootModule().Resources {
+		if r.Type != "pagerduty_user_notification_profile" {
+			continue
+		}
+
+		for k, id := range r.Primary.Attributes {
+			if !regexp.MustCompile(`^contact_method\.\d+\.id$`).MatchString(k) {
+				continue
+			}
+			if _, _, err := client.Users.GetContactMethod(r.Primary.ID, id); err == nil {
+				return fmt.Errorf("Contact method %s still exists", id)
+			}
+		}
+	}
+	return nil
+}
+
+func testAccCheckPagerDutyUserNotificationProfileExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No user ID is set")
+		}
+
+		client, _ := testAccProvider.Meta().(*Config).Client()
+
+		for k, id := range rs.Primary.Attributes {
+			if !regexp.MustCompile(`^(high|low)_urgency_rule\.\d+\.id$`).MatchString(k) {
+				continue
+			}
+			if _, _, err := client.Users.GetNotificationRule(rs.Primary.ID, id); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckPagerDutyUserNotificationProfileConfig(username, email, contactMethod string, delay int) string {
+	return fmt.Sprintf(`
+resource "pagerduty_user" "foo" {
+  name  = "%s"
+  email = "%s"
+}
+
+resource "pagerduty_user_notification_profile" "foo" {
+  user_id = pagerduty_user.foo.id
+  mode    = "remove"
+
+  contact_method {
+    key          = "mobile"
+    type         = "phone_contact_method"
+    address      = "(415) 301-3250"
+    country_code = 1
+    label        = "Mobile"
+  }
+
+  contact_method {
+    key     = "work"
+    type    = "email_contact_method"
+    address = "work.%s"
+    label   = "Work"
+  }
+
+  high_urgency_rule {
+    contact_method         = "%s"
+    start_delay_in_minutes = %d
+  }
+
+  low_urgency_rule {
+    contact_method = "work"
+  }
+}
+`, username, email, email, contactMethod, delay)
+}
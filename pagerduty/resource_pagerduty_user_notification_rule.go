@@ -1,12 +1,15 @@
 package pagerduty
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"regexp"
 	"strings"
+	"sync"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -15,10 +18,11 @@ import (
 
 func resourcePagerDutyUserNotificationRule() *schema.Resource {
 	return &schema.Resource{
-		Create: resourcePagerDutyUserNotificationRuleCreate,
-		Read:   resourcePagerDutyUserNotificationRuleRead,
-		Update: resourcePagerDutyUserNotificationRuleUpdate,
-		Delete: resourcePagerDutyUserNotificationRuleDelete,
+		Create:        resourcePagerDutyUserNotificationRuleCreate,
+		ReadContext:   resourcePagerDutyUserNotificationRuleRefresh,
+		Update:        resourcePagerDutyUserNotificationRuleUpdate,
+		Delete:        resourcePagerDutyUserNotificationRuleDelete,
+		CustomizeDiff: resourcePagerDutyUserNotificationRuleCustomizeDiff,
 		Importer: &schema.ResourceImporter{
 			State: resourcePagerDutyUserNotificationRuleImport,
 		},
@@ -56,6 +60,130 @@ func resourcePagerDutyUserNotificationRule() *schema.Resource {
 	}
 }
 
+// userNotificationRulePlan holds the notification rules that
+// pagerduty_user_notification_rule resources plan for each user, so that
+// duplicates spanning several resources are caught at plan time.
+type userNotificationRulePlan struct {
+	mu      sync.Mutex
+	planned map[string][]*plannedNotificationRule
+}
+
+type plannedNotificationRule struct {
+	id   string
+	rule *pagerduty.NotificationRule
+}
+
+func newUserNotificationRulePlan() *userNotificationRulePlan {
+	return &userNotificationRulePlan{planned: make(map[string][]*plannedNotificationRule)}
+}
+
+// plan records the rule of a resource, whose ID is empty until it's created,
+// and returns the planned rule it duplicates, if any, along with all the
+// rules planned for the user so far.
+func (p *userNotificationRulePlan) plan(userID, id string, rule *pagerduty.NotificationRule) (*plannedNotificationRule, []*plannedNotificationRule) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	var duplicate *plannedNotificationRule
+	replaced := false
+
+	for _, r := range p.planned[userID] {
+		if id != "" && r.id == id {
+			r.rule = rule
+			replaced = true
+			continue
+		}
+		if duplicate == nil && sameNotificationRule(r.rule, rule) {
+			duplicate = r
+		}
+	}
+
+	if !replaced {
+		p.planned[userID] = append(p.planned[userID], &plannedNotificationRule{id: id, rule: rule})
+	}
+
+	return duplicate, append([]*plannedNotificationRule(nil), p.planned[userID]...)
+}
+
+// sameNotificationRule reports whether PagerDuty would reject two rules as
+// duplicates.
+func sameNotificationRule(a, b *pagerduty.NotificationRule) bool {
+	return a.Urgency == b.Urgency &&
+		a.StartDelayInMinutes == b.StartDelayInMinutes &&
+		a.ContactMethod != nil && b.ContactMethod != nil &&
+		a.ContactMethod.ID == b.ContactMethod.ID
+}
+
+func isImmediateHighUrgencyRule(r *pagerduty.NotificationRule) bool {
+	return r.Urgency == "high" && r.StartDelayInMinutes == 0
+}
+
+func resourcePagerDutyUserNotificationRuleCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	// Rules of users or contact methods created in the same apply can't
+	// duplicate a rule that exists in PagerDuty already.
+	for _, k := range []string{"user_id", "urgency", "start_delay_in_minutes", "contact_method"} {
+		if !diff.NewValueKnown(k) {
+			return nil
+		}
+	}
+
+	contactMethod, err := expandContactMethod(diff.Get("contact_method"))
+	if err != nil || contactMethod.ID == "" {
+		return nil
+	}
+
+	return planUserNotificationRule(meta.(*Config), diff.Get("user_id").(string), diff.Id(), &pagerduty.NotificationRule{
+		StartDelayInMinutes: diff.Get("start_delay_in_minutes").(int),
+		Urgency:             diff.Get("urgency").(string),
+		ContactMethod:       contactMethod,
+	})
+}
+
+// planUserNotificationRule records the rule of a resource in the plan of the
+// provider. It fails when another rule of the plan has the same contact
+// method, urgency and delay.
+func planUserNotificationRule(config *Config, userID, id string, rule *pagerduty.NotificationRule) error {
+	if config.userNotificationRulePlan == nil {
+		return nil
+	}
+
+	duplicate, _ := config.userNotificationRulePlan.plan(userID, id, rule)
+	if duplicate != nil {
+		other := "another notification rule"
+		if duplicate.id != "" {
+			other = fmt.Sprintf("notification rule %s", duplicate.id)
+		}
+		return fmt.Errorf("%s of user %s already notifies contact method %s of %s urgency incidents after %d minutes", other, userID, rule.ContactMethod.ID, rule.Urgency, rule.StartDelayInMinutes)
+	}
+
+	return nil
+}
+
+// checkImmediateHighUrgencyRule warns when a user has delayed high urgency
+// notification rules but none firing immediately. It's checked against the
+// rules of the user in PagerDuty once they are applied, rather than while
+// planning, since rules are planned one at a time.
+func checkImmediateHighUrgencyRule(client *pagerduty.Client, userID string) diag.Diagnostics {
+	// The user is served from the cache when it's enabled.
+	user, _, err := client.Users.Get(userID, &pagerduty.GetUserOptions{Include: []string{"notification_rules"}})
+	if err != nil {
+		log.Printf("[DEBUG] Couldn't get the notification rules of user %s: %s", userID, err)
+		return nil
+	}
+
+	for _, r := range user.NotificationRules {
+		if isImmediateHighUrgencyRule(r) {
+			return nil
+		}
+	}
+
+	return diag.Diagnostics{{
+		Severity: diag.Warning,
+		Summary:  fmt.Sprintf("User %s has no immediate high urgency notification rule", userID),
+		Detail:   fmt.Sprintf("User %s has no high urgency notification rule with a start_delay_in_minutes of 0, so they aren't notified as soon as a high urgency incident is assigned to them.", userID),
+	}}
+}
+
 func buildUserNotificationRuleStruct(d *schema.ResourceData) (*pagerduty.NotificationRule, error) {
 	contactMethod, err := expandContactMethod(d.Get("contact_method"))
 	if err != nil {
@@ -119,6 +247,26 @@ func resourcePagerDutyUserNotificationRuleRead(d *schema.ResourceData, meta inte
 	return fetchPagerDutyUserNotificationRule(d, meta, handleNotFoundError)
 }
 
+// resourcePagerDutyUserNotificationRuleRefresh reads the rule, and warns
+// when it delays the high urgency notifications of a user who has no rule
+// firing immediately.
+func resourcePagerDutyUserNotificationRuleRefresh(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if err := resourcePagerDutyUserNotificationRuleRead(d, meta); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.Id() == "" || d.Get("urgency").(string) != "high" || d.Get("start_delay_in_minutes").(int) == 0 {
+		return nil
+	}
+
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return checkImmediateHighUrgencyRule(client, d.Get("user_id").(string))
+}
+
 func resourcePagerDutyUserNotificationRuleUpdate(d *schema.ResourceData, meta interface{}) error {
 	client, _ := meta.(*Config).Client()
 
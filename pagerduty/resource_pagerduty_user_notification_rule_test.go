@@ -1,15 +1,133 @@
 package pagerduty
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"regexp"
+	"strings"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/heimweh/go-pagerduty/pagerduty"
 )
 
+func TestPlanUserNotificationRule(t *testing.T) {
+	config := &Config{userNotificationRulePlan: newUserNotificationRulePlan()}
+
+	rule := func(urgency string, delay int, contactMethodID string) *pagerduty.NotificationRule {
+		return &pagerduty.NotificationRule{
+			Urgency:             urgency,
+			StartDelayInMinutes: delay,
+			ContactMethod:       &pagerduty.ContactMethodReference{ID: contactMethodID, Type: "email_contact_method"},
+		}
+	}
+
+	if err := planUserNotificationRule(config, "PUSER", "PRULE1", rule("high", 5, "PEMAIL")); err != nil {
+		t.Fatal(err)
+	}
+
+	// Planning a resource again replaces its rule.
+	if err := planUserNotificationRule(config, "PUSER", "PRULE1", rule("high", 5, "PEMAIL")); err != nil {
+		t.Errorf("expected a rule planned twice not to duplicate itself, got %v", err)
+	}
+
+	if err := planUserNotificationRule(config, "PUSER", "", rule("high", 5, "PEMAIL")); err == nil || !strings.Contains(err.Error(), "notification rule PRULE1 of user PUSER") {
+		t.Errorf("expected a duplicate error, got %v", err)
+	}
+
+	if err := planUserNotificationRule(config, "PUSER", "", rule("low", 5, "PEMAIL")); err != nil {
+		t.Errorf("expected rules of another urgency not to be duplicates, got %v", err)
+	}
+	if err := planUserNotificationRule(config, "POTHER", "", rule("high", 5, "PEMAIL")); err != nil {
+		t.Errorf("expected rules of other users to be ignored, got %v", err)
+	}
+}
+
+func TestPagerDutyUserNotificationRuleRefresh_ImmediateRuleWarning(t *testing.T) {
+	var rules []*pagerduty.NotificationRule
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/users/PUSER/notification_rules/PRULE1":
+			json.NewEncoder(w).Encode(&pagerduty.NotificationRulePayload{NotificationRule: rules[0]})
+		case "/users/PUSER":
+			json.NewEncoder(w).Encode(&pagerduty.UserPayload{User: &pagerduty.User{ID: "PUSER", NotificationRules: rules}})
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	config := &Config{Token: "foo", ApiUrlOverride: srv.URL, SkipCredsValidation: true}
+
+	rule := func(id, urgency string, delay int) *pagerduty.NotificationRule {
+		return &pagerduty.NotificationRule{
+			ID:                  id,
+			Urgency:             urgency,
+			StartDelayInMinutes: delay,
+			ContactMethod:       &pagerduty.ContactMethodReference{ID: "PEMAIL", Type: "email_contact_method"},
+		}
+	}
+
+	refresh := func() diag.Diagnostics {
+		d := resourcePagerDutyUserNotificationRule().TestResourceData()
+		d.SetId("PRULE1")
+		d.Set("user_id", "PUSER")
+		return resourcePagerDutyUserNotificationRuleRefresh(context.Background(), d, config)
+	}
+
+	cases := []struct {
+		name  string
+		rules []*pagerduty.NotificationRule
+		warn  bool
+	}{
+		{"delayed high urgency rule only", []*pagerduty.NotificationRule{rule("PRULE1", "high", 5)}, true},
+		{"immediate low urgency rule", []*pagerduty.NotificationRule{rule("PRULE1", "high", 5), rule("PRULE2", "low", 0)}, true},
+		{"immediate high urgency rule", []*pagerduty.NotificationRule{rule("PRULE1", "high", 5), rule("PRULE2", "high", 0)}, false},
+		{"immediate rule itself", []*pagerduty.NotificationRule{rule("PRULE1", "high", 0)}, false},
+		{"delayed low urgency rule", []*pagerduty.NotificationRule{rule("PRULE1", "low", 5)}, false},
+	}
+
+	for _, c := range cases {
+		rules = c.rules
+		diags := refresh()
+		if diags.HasError() {
+			t.Fatalf("%s: %v", c.name, diags)
+		}
+		if c.warn != (len(diags) == 1 && diags[0].Severity == diag.Warning && strings.Contains(diags[0].Detail, "start_delay_in_minutes of 0")) {
+			t.Errorf("%s: expected a warning %t, got %v", c.name, c.warn, diags)
+		}
+	}
+}
+
+func TestAccPagerDutyUserNotificationRule_Duplicate(t *testing.T) {
+	username := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	email := fmt.Sprintf("%s@foo.com", username)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPagerDutyUserNotificationRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckPagerDutyUserNotificationRuleConfig_Duplicate(username, email, 0),
+			},
+			{
+				Config:      testAccCheckPagerDutyUserNotificationRuleConfig_Duplicate(username, email, 2),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("already notifies contact method"),
+			},
+		},
+	})
+}
+
 func TestAccPagerDutyUserNotificationRuleContactMethod_Basic(t *testing.T) {
 	username := fmt.Sprintf("tf-%s", acctest.RandString(5))
 	email := fmt.Sprintf("%s@foo.com", username)
@@ -316,3 +434,31 @@ resource "pagerduty_user_contact_method" "email_contact_method" {
 }
 `, username, email)
 }
+
+func testAccCheckPagerDutyUserNotificationRuleConfig_Duplicate(username, email string, count int) string {
+	return fmt.Sprintf(`
+resource "pagerduty_user" "foo" {
+  name  = "%s"
+  email = "%s"
+}
+
+resource "pagerduty_user_contact_method" "foo" {
+  user_id = pagerduty_user.foo.id
+  type    = "email_contact_method"
+  address = "%s"
+  label   = "foo"
+}
+
+resource "pagerduty_user_notification_rule" "foo" {
+  count                  = %d
+  user_id                = pagerduty_user.foo.id
+  start_delay_in_minutes = 1
+  urgency                = "high"
+
+  contact_method = {
+    id   = pagerduty_user_contact_method.foo.id
+    type = pagerduty_user_contact_method.foo.type
+  }
+}
+`, username, email, "work."+email, count)
+}
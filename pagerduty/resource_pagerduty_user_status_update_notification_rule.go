@@ -0,0 +1,165 @@
+package pagerduty
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/heimweh/go-pagerduty/pagerduty"
+)
+
+func resourcePagerDutyUserStatusUpdateNotificationRule() *schema.Resource {
+	return &schema.Resource{
+		Create: resourcePagerDutyUserStatusUpdateNotificationRuleCreate,
+		Read:   resourcePagerDutyUserStatusUpdateNotificationRuleRead,
+		Update: resourcePagerDutyUserStatusUpdateNotificationRuleUpdate,
+		Delete: resourcePagerDutyUserStatusUpdateNotificationRuleDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourcePagerDutyUserStatusUpdateNotificationRuleImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"user_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"contact_method": {
+				Required: true,
+				Type:     schema.TypeMap,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				ValidateDiagFunc: validation.MapKeyMatch(regexp.MustCompile("(id|type)"), "`contact_method` must only have `id` and `types` attributes"),
+			},
+		},
+	}
+}
+
+func buildUserStatusUpdateNotificationRuleStruct(d *schema.ResourceData) (*pagerduty.StatusUpdateNotificationRule, error) {
+	contactMethod, err := expandContactMethod(d.Get("contact_method"))
+	if err != nil {
+		return nil, err
+	}
+
+	// Status updates are sent by email, SMS or push notification, never by phone.
+	if contactMethod.Type == "phone_contact_method" {
+		return nil, fmt.Errorf("the `type` attribute of `contact_method` must be one of `email_contact_method`, `push_notification_contact_method` or `sms_contact_method`")
+	}
+
+	rule := &pagerduty.StatusUpdateNotificationRule{
+		Type:          "status_update_notification_rule",
+		ContactMethod: contactMethod,
+	}
+
+	return rule, nil
+}
+
+func fetchPagerDutyUserStatusUpdateNotificationRule(d *schema.ResourceData, meta interface{}, errCallback func(error, *schema.ResourceData) error) error {
+	client, _ := meta.(*Config).Client()
+	userID := d.Get("user_id").(string)
+
+	return resource.Retry(2*time.Minute, func() *resource.RetryError {
+		resp, _, err := client.Users.GetStatusUpdateNotificationRule(userID, d.Id())
+		if err != nil {
+			errResp := errCallback(err, d)
+			if errResp != nil {
+				time.Sleep(2 * time.Second)
+				return resource.RetryableError(errResp)
+			}
+
+			return nil
+		}
+
+		d.Set("contact_method", flattenContactMethod(resp.ContactMethod))
+
+		return nil
+	})
+}
+
+func resourcePagerDutyUserStatusUpdateNotificationRuleCreate(d *schema.ResourceData, meta interface{}) error {
+	client, _ := meta.(*Config).Client()
+
+	userID := d.Get("user_id").(string)
+
+	rule, err := buildUserStatusUpdateNotificationRuleStruct(d)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Creating PagerDuty user status update notification rule for user %s", userID)
+
+	resp, _, err := client.Users.CreateStatusUpdateNotificationRule(userID, rule)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(resp.ID)
+
+	return fetchPagerDutyUserStatusUpdateNotificationRule(d, meta, genError)
+}
+
+func resourcePagerDutyUserStatusUpdateNotificationRuleRead(d *schema.ResourceData, meta interface{}) error {
+	return fetchPagerDutyUserStatusUpdateNotificationRule(d, meta, handleNotFoundError)
+}
+
+func resourcePagerDutyUserStatusUpdateNotificationRuleUpdate(d *schema.ResourceData, meta interface{}) error {
+	client, _ := meta.(*Config).Client()
+
+	rule, err := buildUserStatusUpdateNotificationRuleStruct(d)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Updating PagerDuty user status update notification rule %s", d.Id())
+
+	userID := d.Get("user_id").(string)
+
+	if _, _, err := client.Users.UpdateStatusUpdateNotificationRule(userID, d.Id(), rule); err != nil {
+		return err
+	}
+
+	return resourcePagerDutyUserStatusUpdateNotificationRuleRead(d, meta)
+}
+
+func resourcePagerDutyUserStatusUpdateNotificationRuleDelete(d *schema.ResourceData, meta interface{}) error {
+	client, _ := meta.(*Config).Client()
+
+	log.Printf("[INFO] Deleting PagerDuty user status update notification rule %s", d.Id())
+
+	userID := d.Get("user_id").(string)
+
+	if _, err := client.Users.DeleteStatusUpdateNotificationRule(userID, d.Id()); err != nil {
+		return handleNotFoundError(err, d)
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func resourcePagerDutyUserStatusUpdateNotificationRuleImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	client, _ := meta.(*Config).Client()
+
+	ids := strings.Split(d.Id(), ":")
+
+	if len(ids) != 2 {
+		return []*schema.ResourceData{}, fmt.Errorf("Error importing pagerduty_user_status_update_notification_rule. Expecting an ID formed as '<user_id>:<status_update_notification_rule_id>'")
+	}
+	uid, id := ids[0], ids[1]
+
+	_, _, err := client.Users.GetStatusUpdateNotificationRule(uid, id)
+	if err != nil {
+		return []*schema.ResourceData{}, err
+	}
+
+	d.SetId(id)
+	d.Set("user_id", uid)
+
+	return []*schema.ResourceData{d}, nil
+}
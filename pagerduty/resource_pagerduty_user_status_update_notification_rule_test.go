@@ -0,0 +1,139 @@
+package pagerduty
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccPagerDutyUserStatusUpdateNotificationRule_Basic(t *testing.T) {
+	username := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	email := fmt.Sprintf("%s@foo.com", username)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPagerDutyUserStatusUpdateNotificationRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckPagerDutyUserStatusUpdateNotificationRuleConfig("email_contact_method", username, email),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyUserStatusUpdateNotificationRuleExists("pagerduty_user_status_update_notification_rule.foo"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_user_status_update_notification_rule.foo", "contact_method.type", "email_contact_method"),
+				),
+			},
+			{
+				Config: testAccCheckPagerDutyUserStatusUpdateNotificationRuleConfig("sms_contact_method", username, email),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyUserStatusUpdateNotificationRuleExists("pagerduty_user_status_update_notification_rule.foo"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_user_status_update_notification_rule.foo", "contact_method.type", "sms_contact_method"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccPagerDutyUserStatusUpdateNotificationRule_Phone(t *testing.T) {
+	username := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	email := fmt.Sprintf("%s@foo.com", username)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPagerDutyUserStatusUpdateNotificationRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckPagerDutyUserStatusUpdateNotificationRuleConfig("phone_contact_method", username, email),
+				ExpectError: regexp.MustCompile("the `type` attribute of `contact_method` must be one of `email_contact_method`, `push_notification_contact_method` or `sms_contact_method`"),
+			},
+		},
+	})
+}
+
+func testAccCheckPagerDutyUserStatusUpdateNotificationRuleDestroy(s *terraform.State) error {
+	client, _ := testAccProvider.Meta().(*Config).Client()
+	for _, r := range s.RootModule().Resources {
+		if r.Type != "pagerduty_user_status_update_notification_rule" {
+			continue
+		}
+
+		if _, _, err := client.Users.GetStatusUpdateNotificationRule(r.Primary.Attributes["user_id"], r.Primary.ID); err == nil {
+			return fmt.Errorf("User status update notification rule still exists")
+		}
+
+	}
+	return nil
+}
+
+func testAccCheckPagerDutyUserStatusUpdateNotificationRuleExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No user status update notification rule ID is set")
+		}
+
+		client, _ := testAccProvider.Meta().(*Config).Client()
+
+		found, _, err := client.Users.GetStatusUpdateNotificationRule(rs.Primary.Attributes["user_id"], rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return fmt.Errorf("Status update notification rule not found: %v - %v", rs.Primary.ID, found)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckPagerDutyUserStatusUpdateNotificationRuleConfig(methodType, username, email string) string {
+	return fmt.Sprintf(`
+resource "pagerduty_user_status_update_notification_rule" "foo" {
+  user_id = pagerduty_user.foo.id
+
+  contact_method = {
+    type = "%[1]v"
+    id   = pagerduty_user_contact_method.%[1]v.id
+  }
+}
+
+resource "pagerduty_user" "foo" {
+  name  = "%s"
+  email = "%s"
+}
+
+resource "pagerduty_user_contact_method" "email_contact_method" {
+  user_id = pagerduty_user.foo.id
+  type    = "email_contact_method"
+  address = "foo-status@bar.com"
+  label   = "Work"
+}
+
+resource "pagerduty_user_contact_method" "sms_contact_method" {
+  user_id      = pagerduty_user.foo.id
+  type         = "sms_contact_method"
+  address      = "8015541234"
+  country_code = "+1"
+  label        = "Work"
+}
+
+resource "pagerduty_user_contact_method" "phone_contact_method" {
+  user_id      = pagerduty_user.foo.id
+  type         = "phone_contact_method"
+  country_code = "+1"
+  address      = "8015541234"
+  label        = "Work"
+}
+`, methodType, username, email)
+}
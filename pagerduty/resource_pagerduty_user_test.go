@@ -2,7 +2,10 @@ package pagerduty
 
 import (
 	"fmt"
+	"io/ioutil"
 	"log"
+	"net/http"
+	"net/http/httptest"
 	"strings"
 	"testing"
 
@@ -52,6 +55,91 @@ func testSweepUser(region string) error {
 	return nil
 }
 
+func TestFetchUserSchedules(t *testing.T) {
+	requests := make(map[string]int)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests[r.URL.Path]++
+		switch r.URL.Path {
+		case "/schedules":
+			if r.URL.Query().Get("offset") == "" {
+				w.Write([]byte(`{"schedules":[{"id":"PSCHED1","users":[{"id":"PUSER01"}]},{"id":"PSCHED2","users":[{"id":"POTHER1"}]}],"limit":2,"more":true}`))
+				return
+			}
+			w.Write([]byte(`{"schedules":[{"id":"PSCHED3","users":[{"id":"POTHER1"},{"id":"PUSER01"}]}],"limit":2,"more":false}`))
+		case "/schedules/PSCHED1":
+			w.Write([]byte(`{"schedule":{"id":"PSCHED1","users":[{"id":"PUSER01"}]}}`))
+		case "/schedules/PSCHED3":
+			w.Write([]byte(`{"schedule":{"id":"PSCHED3","users":[{"id":"POTHER1"},{"id":"PUSER01"}]}}`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	}))
+	defer srv.Close()
+
+	client, err := (&Config{Token: "foo", ApiUrlOverride: srv.URL, SkipCredsValidation: true}).Client()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	schedules, err := fetchUserSchedules(client, "PUSER01")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(schedules) != 2 || schedules[0].ID != "PSCHED1" || schedules[1].ID != "PSCHED3" {
+		t.Errorf("expected PSCHED1 and PSCHED3, got %v", schedules)
+	}
+	if requests["/schedules"] != 2 || requests["/schedules/PSCHED2"] != 0 {
+		t.Errorf("expected every page to be listed and only the schedules of the user to be read, got %v", requests)
+	}
+}
+
+// A schedule including the user that none of its escalation policies
+// targets still blocks the delete, and is reassigned.
+func TestDetachUser_ScheduleNotTargeted(t *testing.T) {
+	var updated string
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/escalation_policies":
+			w.Write([]byte(`{"escalation_policies":[]}`))
+		case r.Method == "GET" && r.URL.Path == "/schedules":
+			w.Write([]byte(`{"schedules":[{"id":"PSCHED1","name":"Standby","users":[{"id":"PUSER01"}]}]}`))
+		case r.Method == "GET" && r.URL.Path == "/schedules/PSCHED1":
+			w.Write([]byte(`{"schedule":{"id":"PSCHED1","name":"Standby","time_zone":"UTC","users":[{"id":"PUSER01"}],"schedule_layers":[{"id":"PLAYER1","name":"Layer 1","users":[{"user":{"id":"PUSER01","type":"user_reference"}}]}]}}`))
+		case r.Method == "PUT" && r.URL.Path == "/schedules/PSCHED1":
+			b, _ := ioutil.ReadAll(r.Body)
+			updated = string(b)
+			w.Write([]byte(`{"schedule":{"id":"PSCHED1"}}`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	}))
+	defer srv.Close()
+
+	client, err := (&Config{Token: "foo", ApiUrlOverride: srv.URL, SkipCredsValidation: true}).Client()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	d := resourcePagerDutyUser().TestResourceData()
+	d.SetId("PUSER01")
+	d.Set("name", "Jane Doe")
+	if err := detachUser(client, d); err == nil || !strings.Contains(err.Error(), `schedule "Standby" (PSCHED1)`) {
+		t.Errorf("expected the schedule to block the delete, got %v", err)
+	}
+
+	d.Set("reassign_to_user", "PUSER02")
+	if err := detachUser(client, d); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(updated, `"PUSER02"`) || strings.Contains(updated, `"PUSER01"`) {
+		t.Errorf("expected the layer to be reassigned to PUSER02, got %s", updated)
+	}
+}
+
 func TestAccPagerDutyUser_Basic(t *testing.T) {
 	username := fmt.Sprintf("tf-%s", acctest.RandString(5))
 	usernameSpaces := " " + username + " "
@@ -151,6 +239,93 @@ func TestAccPagerDutyUserWithTeams_Basic(t *testing.T) {
 	})
 }
 
+func TestAccPagerDutyUser_ReassignOnDestroy(t *testing.T) {
+	username := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	email := fmt.Sprintf("%s@foo.com", username)
+	reassignName := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	reassignEmail := fmt.Sprintf("%s@foo.com", reassignName)
+	escalationPolicy := fmt.Sprintf("tf-%s", acctest.RandString(5))
+
+	// The escalation policy is created outside of Terraform, so Terraform
+	// can't remove the user from it before destroying the user.
+	var escalationPolicyID string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPagerDutyUserDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckPagerDutyUserReassignOnDestroyConfig(username, email, reassignName, reassignEmail),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyUserExists("pagerduty_user.foo"),
+					resource.TestCheckResourceAttrPair(
+						"pagerduty_user.foo", "reassign_to_user", "pagerduty_user.bar", "id"),
+					testAccCreatePagerDutyUserEscalationPolicy(escalationPolicy, &escalationPolicyID),
+				),
+			},
+			{
+				Config: testAccCheckPagerDutyUserReassignOnDestroyConfigRemoved(reassignName, reassignEmail),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyUserReassigned(&escalationPolicyID),
+				),
+			},
+		},
+	})
+}
+
+// testAccCreatePagerDutyUserEscalationPolicy creates an escalation policy that
+// targets pagerduty_user.foo.
+func testAccCreatePagerDutyUserEscalationPolicy(name string, id *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client, _ := testAccProvider.Meta().(*Config).Client()
+
+		ep, _, err := client.EscalationPolicies.Create(&pagerduty.EscalationPolicy{
+			Name: name,
+			EscalationRules: []*pagerduty.EscalationRule{
+				{
+					EscalationDelayInMinutes: 10,
+					Targets: []*pagerduty.EscalationTargetReference{
+						{
+							ID:   s.RootModule().Resources["pagerduty_user.foo"].Primary.ID,
+							Type: "user_reference",
+						},
+					},
+				},
+			},
+		})
+		if err != nil {
+			return err
+		}
+
+		*id = ep.ID
+
+		return nil
+	}
+}
+
+func testAccCheckPagerDutyUserReassigned(id *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client, _ := testAccProvider.Meta().(*Config).Client()
+
+		ep, _, err := client.EscalationPolicies.Get(*id, &pagerduty.GetEscalationPolicyOptions{})
+		if err != nil {
+			return err
+		}
+
+		want := s.RootModule().Resources["pagerduty_user.bar"].Primary.ID
+		if len(ep.EscalationRules) != 1 || len(ep.EscalationRules[0].Targets) != 1 || ep.EscalationRules[0].Targets[0].ID != want {
+			return fmt.Errorf("Escalation policy %s was not reassigned to user %s", ep.ID, want)
+		}
+
+		if _, err := client.EscalationPolicies.Delete(ep.ID); err != nil {
+			return err
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckPagerDutyUserDestroy(s *terraform.State) error {
 	client, _ := testAccProvider.Meta().(*Config).Client()
 	for _, r := range s.RootModule().Resources {
@@ -278,3 +453,27 @@ resource "pagerduty_user" "foo" {
 }
 `, team1, team2, username, email)
 }
+
+func testAccCheckPagerDutyUserReassignOnDestroyConfig(username, email, reassignName, reassignEmail string) string {
+	return fmt.Sprintf(`
+resource "pagerduty_user" "foo" {
+  name             = "%s"
+  email            = "%s"
+  reassign_to_user = pagerduty_user.bar.id
+}
+
+resource "pagerduty_user" "bar" {
+  name  = "%s"
+  email = "%s"
+}
+`, username, email, reassignName, reassignEmail)
+}
+
+func testAccCheckPagerDutyUserReassignOnDestroyConfigRemoved(reassignName, reassignEmail string) string {
+	return fmt.Sprintf(`
+resource "pagerduty_user" "bar" {
+  name  = "%s"
+  email = "%s"
+}
+`, reassignName, reassignEmail)
+}
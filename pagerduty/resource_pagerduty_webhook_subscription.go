@@ -1,6 +1,8 @@
 package pagerduty
 
 import (
+	"context"
+	"fmt"
 	"log"
 	"time"
 
@@ -15,6 +17,9 @@ func resourcePagerDutyWebhookSubscription() *schema.Resource {
 		Read:   resourcePagerDutyWebhookSubscriptionRead,
 		Update: resourcePagerDutyWebhookSubscriptionUpdate,
 		Delete: resourcePagerDutyWebhookSubscriptionDelete,
+		CustomizeDiff: func(context context.Context, diff *schema.ResourceDiff, i interface{}) error {
+			return validateWebhookSubscriptionFilter(diff.Get("filter.0.type").(string), diff.Get("filter.0.id").(string) != "" || !diff.NewValueKnown("filter.0.id"))
+		},
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -41,9 +46,31 @@ func resourcePagerDutyWebhookSubscription() *schema.Resource {
 							Type:     schema.TypeString,
 							Optional: true,
 						},
+						"custom_header": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"value": {
+										Type:      schema.TypeString,
+										Required:  true,
+										Sensitive: true,
+									},
+								},
+							},
+						},
 					},
 				},
 			},
+			"secret": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
 			"type": {
 				Type:     schema.TypeString,
 				Default:  "webhook_subscription",
@@ -65,7 +92,8 @@ func resourcePagerDutyWebhookSubscription() *schema.Resource {
 				Type:     schema.TypeList,
 				Required: true,
 				Elem: &schema.Schema{
-					Type: schema.TypeString,
+					Type:         schema.TypeString,
+					ValidateFunc: validateValueFunc(webhookSubscriptionEvents),
 				},
 			},
 			"filter": {
@@ -93,6 +121,46 @@ func resourcePagerDutyWebhookSubscription() *schema.Resource {
 	}
 }
 
+var webhookSubscriptionEvents = []string{
+	"incident.acknowledged",
+	"incident.annotated",
+	"incident.conference_bridge.updated",
+	"incident.custom_field_values.updated",
+	"incident.delegated",
+	"incident.escalated",
+	"incident.priority_updated",
+	"incident.reassigned",
+	"incident.reopened",
+	"incident.resolved",
+	"incident.responder.added",
+	"incident.responder.replied",
+	"incident.status_update_published",
+	"incident.triggered",
+	"incident.unacknowledged",
+	"incident.workflow.completed",
+	"incident.workflow.started",
+	"service.created",
+	"service.deleted",
+	"service.updated",
+}
+
+// validateWebhookSubscriptionFilter checks that only service and team filters
+// reference an object, the account filter applies to the whole account.
+func validateWebhookSubscriptionFilter(filterType string, hasID bool) error {
+	switch filterType {
+	case "account_reference":
+		if hasID {
+			return fmt.Errorf("filter of type account_reference cannot have an id")
+		}
+	case "service_reference", "team_reference":
+		if !hasID {
+			return fmt.Errorf("filter of type %s needs the id of the %s", filterType, filterType[:len(filterType)-len("_reference")])
+		}
+	}
+
+	return nil
+}
+
 func buildWebhookSubscriptionStruct(d *schema.ResourceData) *pagerduty.WebhookSubscription {
 	webhook := pagerduty.WebhookSubscription{
 		Type:           d.Get("type").(string),
@@ -121,6 +189,8 @@ func resourcePagerDutyWebhookSubscriptionCreate(d *schema.ResourceData, meta int
 			return resource.NonRetryableError(err)
 		} else if webhook != nil {
 			d.SetId(webhook.ID)
+			// The secret is only returned when the subscription is created.
+			d.Set("secret", webhook.DeliveryMethod.Secret)
 		}
 		return nil
 	})
@@ -183,7 +253,7 @@ func setWebhookResourceData(d *schema.ResourceData, webhook *pagerduty.WebhookSu
 	d.Set("active", webhook.Active)
 	d.Set("description", webhook.Description)
 	d.Set("events", flattenConfigList(webhook.Events))
-	d.Set("delivery_method", flattenDeliveryMethod(webhook.DeliveryMethod))
+	d.Set("delivery_method", flattenDeliveryMethod(webhook.DeliveryMethod, d.Get("delivery_method").([]interface{})))
 	d.Set("filter", flattenFilter(webhook.Filter))
 }
 
@@ -196,6 +266,15 @@ func expandDeliveryMethod(v interface{}) pagerduty.DeliveryMethod {
 		TemporarilyDisabled: dmMap["temporarily_disabled"].(bool),
 		Type:                dmMap["type"].(string),
 		URL:                 dmMap["url"].(string),
+		CustomHeaders:       []*pagerduty.DeliveryMethodCustomHeader{},
+	}
+
+	for _, h := range dmMap["custom_header"].([]interface{}) {
+		header := h.(map[string]interface{})
+		method.CustomHeaders = append(method.CustomHeaders, &pagerduty.DeliveryMethodCustomHeader{
+			Name:  header["name"].(string),
+			Value: header["value"].(string),
+		})
 	}
 	return method
 }
@@ -211,12 +290,35 @@ func expandFilter(v interface{}) pagerduty.Filter {
 	return filter
 }
 
-func flattenDeliveryMethod(method pagerduty.DeliveryMethod) []map[string]interface{} {
+// flattenDeliveryMethod keeps the configured values of the custom headers, as
+// PagerDuty redacts them when the subscription is read.
+func flattenDeliveryMethod(method pagerduty.DeliveryMethod, old []interface{}) []map[string]interface{} {
+	values := make(map[string]string)
+	if len(old) > 0 && old[0] != nil {
+		for _, h := range old[0].(map[string]interface{})["custom_header"].([]interface{}) {
+			header := h.(map[string]interface{})
+			values[header["name"].(string)] = header["value"].(string)
+		}
+	}
+
+	var headers []map[string]interface{}
+	for _, h := range method.CustomHeaders {
+		value, ok := values[h.Name]
+		if !ok {
+			value = h.Value
+		}
+		headers = append(headers, map[string]interface{}{
+			"name":  h.Name,
+			"value": value,
+		})
+	}
+
 	var methods []map[string]interface{}
 	methodMap := map[string]interface{}{
 		"temporarily_disabled": method.TemporarilyDisabled,
 		"type":                 method.Type,
 		"url":                  method.URL,
+		"custom_header":        headers,
 	}
 	methods = append(methods, methodMap)
 	return methods
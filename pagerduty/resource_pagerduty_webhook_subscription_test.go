@@ -3,6 +3,7 @@ package pagerduty
 import (
 	"fmt"
 	"log"
+	"regexp"
 	"strings"
 	"testing"
 
@@ -71,6 +72,65 @@ func TestAccPagerDutyWebhookSubscription_Basic(t *testing.T) {
 	})
 }
 
+func TestAccPagerDutyWebhookSubscription_CustomHeaders(t *testing.T) {
+	description := fmt.Sprintf("tf-test-%s", acctest.RandString(5))
+	username := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	email := fmt.Sprintf("%s@foo.com", username)
+	escalationPolicy := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	service := fmt.Sprintf("tf-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPagerDutyWebhookSubscriptionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckPagerDutyWebhookSubscriptionCustomHeadersConfig(username, email, escalationPolicy, service, description, "account_reference"),
+				ExpectError: regexp.MustCompile("filter of type account_reference cannot have an id"),
+			},
+			{
+				Config: testAccCheckPagerDutyWebhookSubscriptionCustomHeadersConfig(username, email, escalationPolicy, service, description, "service_reference"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyWebhookSubscriptionExists("pagerduty_webhook_subscription.foo"),
+					resource.TestCheckResourceAttrSet(
+						"pagerduty_webhook_subscription.foo", "secret"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_webhook_subscription.foo", "delivery_method.0.custom_header.#", "1"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_webhook_subscription.foo", "delivery_method.0.custom_header.0.name", "X-Auth-Token"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_webhook_subscription.foo", "delivery_method.0.custom_header.0.value", "foo"),
+				),
+			},
+		},
+	})
+}
+
+func TestValidateWebhookSubscriptionFilter(t *testing.T) {
+	cases := []struct {
+		filterType string
+		hasID      bool
+		valid      bool
+	}{
+		{"account_reference", false, true},
+		{"account_reference", true, false},
+		{"service_reference", true, true},
+		{"service_reference", false, false},
+		{"team_reference", true, true},
+		{"team_reference", false, false},
+	}
+
+	for _, c := range cases {
+		err := validateWebhookSubscriptionFilter(c.filterType, c.hasID)
+		if c.valid && err != nil {
+			t.Errorf("expected %s filter with id %t to be valid, got %v", c.filterType, c.hasID, err)
+		}
+		if !c.valid && err == nil {
+			t.Errorf("expected %s filter with id %t to be invalid", c.filterType, c.hasID)
+		}
+	}
+}
+
 func testAccCheckPagerDutyWebhookSubscriptionDestroy(s *terraform.State) error {
 	client, _ := testAccProvider.Meta().(*Config).Client()
 	for _, r := range s.RootModule().Resources {
@@ -171,3 +231,52 @@ func testAccCheckPagerDutyWebhookSubscriptionConfig(username, useremail, escalat
 	}
 	`, username, useremail, escalationPolicy, service, description)
 }
+
+func testAccCheckPagerDutyWebhookSubscriptionCustomHeadersConfig(username, useremail, escalationPolicy, service, description, filterType string) string {
+	return fmt.Sprintf(`
+resource "pagerduty_user" "foo" {
+  name  = "%s"
+  email = "%s"
+}
+
+resource "pagerduty_escalation_policy" "foo" {
+  name      = "%s"
+  num_loops = 1
+
+  rule {
+    escalation_delay_in_minutes = 10
+
+    target {
+      type = "user_reference"
+      id   = pagerduty_user.foo.id
+    }
+  }
+}
+
+resource "pagerduty_service" "foo" {
+  name              = "%s"
+  escalation_policy = pagerduty_escalation_policy.foo.id
+}
+
+resource "pagerduty_webhook_subscription" "foo" {
+  description = "%s"
+  events      = ["incident.triggered", "service.updated"]
+  active      = true
+
+  delivery_method {
+    type = "http_delivery_method"
+    url  = "https://example.com/receive_a_pagerduty_webhook"
+
+    custom_header {
+      name  = "X-Auth-Token"
+      value = "foo"
+    }
+  }
+
+  filter {
+    id   = pagerduty_service.foo.id
+    type = "%s"
+  }
+}
+`, username, useremail, escalationPolicy, service, description, filterType)
+}
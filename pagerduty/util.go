@@ -29,6 +29,14 @@ func validateRFC3339(v interface{}, k string) (we []string, errors []error) {
 	return
 }
 
+// validateTimeZone validates that a string is an IANA time zone
+func validateTimeZone(v interface{}, k string) (we []string, errors []error) {
+	if _, err := time.LoadLocation(v.(string)); err != nil {
+		errors = append(errors, fmt.Errorf("%s must be an IANA time zone, e.g. Europe/Paris: %v", k, err))
+	}
+	return
+}
+
 func suppressRFC3339Diff(k, oldTime, newTime string, d *schema.ResourceData) bool {
 	oldT, newT, err := parseRFC3339Time(k, oldTime, newTime)
 	if err != nil {
@@ -123,3 +131,71 @@ func flattenSlice(v []interface{}) interface{} {
 	}
 	return string(b)
 }
+
+// deletionProtectionSchema returns the schema of the deletion_protection argument
+// shared by resources that are expensive to recreate.
+func deletionProtectionSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeBool,
+		Optional: true,
+		Default:  false,
+	}
+}
+
+// setDefaultDeletionProtection sets deletion_protection to false in the state
+// when it has no value, such as after an import, so that the default of the
+// configuration doesn't show up as a change.
+func setDefaultDeletionProtection(d *schema.ResourceData) {
+	if _, ok := d.GetOkExists("deletion_protection"); !ok {
+		d.Set("deletion_protection", false)
+	}
+}
+
+// checkDeletionProtection returns an error if the state of the resource has
+// deletion_protection enabled. Since the state is used, the flag has to be
+// turned off in a previous apply before the resource can be destroyed.
+func checkDeletionProtection(d *schema.ResourceData, resourceType string) error {
+	if d.Get("deletion_protection").(bool) {
+		return fmt.Errorf("%s %q (%s) has deletion_protection enabled. Set deletion_protection to false and apply before destroying or replacing it", resourceType, d.Get("name").(string), d.Id())
+	}
+
+	return nil
+}
+
+// checkDeletionProtectionDiff returns an error if any of the given ForceNew
+// keys change while deletion_protection is enabled in the state.
+func checkDeletionProtectionDiff(diff *schema.ResourceDiff, resourceType string, forceNewKeys ...string) error {
+	if diff.Id() == "" {
+		return nil
+	}
+
+	if old, _ := diff.GetChange("deletion_protection"); !old.(bool) {
+		return nil
+	}
+
+	for _, k := range forceNewKeys {
+		if diff.HasChange(k) {
+			return fmt.Errorf("%s %q (%s) has deletion_protection enabled and changing %s would replace it. Set deletion_protection to false and apply before changing %s", resourceType, diff.Get("name").(string), diff.Id(), k, k)
+		}
+	}
+
+	return nil
+}
+
+// formatDependents formats a list of objects that block a deletion, one per line.
+func formatDependents(dependents []string) string {
+	lines := make([]string, len(dependents))
+	for i, d := range dependents {
+		lines[i] = "  - " + d
+	}
+	return strings.Join(lines, "\n")
+}
+
+// detachOnDestroySchema returns the schema of the detach_on_destroy argument.
+func detachOnDestroySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeBool,
+		Optional: true,
+		Default:  false,
+	}
+}
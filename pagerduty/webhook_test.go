@@ -0,0 +1,160 @@
+package pagerduty
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/heimweh/go-pagerduty/pagerduty"
+)
+
+// The webhook helpers of the client live in vendor/, which go test skips, so
+// they are tested here.
+
+const testWebhookIncidentTriggered = `{"event":{"id":"01BZ4","event_type":"incident.triggered","resource_type":"incident","occurred_at":"2022-01-01T00:00:00Z","data":{"id":"PINC123","type":"incident","number":42,"status":"triggered","title":"Disk full","urgency":"high","service":{"id":"PSVC123","type":"service_reference"}}}}`
+
+func testWebhookSignature(secret, payload string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(payload))
+	return "v1=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+func TestWebhookVerifier(t *testing.T) {
+	payload := []byte(testWebhookIncidentTriggered)
+	old := testWebhookSignature("old-secret", testWebhookIncidentTriggered)
+	rotated := testWebhookSignature("new-secret", testWebhookIncidentTriggered)
+
+	cases := []struct {
+		name    string
+		secrets []string
+		header  string
+		wantErr error
+	}{
+		{"single secret", []string{"old-secret"}, old, nil},
+		{"rotation, payload signed with the new secret", []string{"old-secret", "new-secret"}, rotated, nil},
+		{"rotation, payload signed with both secrets", []string{"new-secret"}, old + ", " + rotated, nil},
+		{"malformed signatures are skipped", []string{"old-secret"}, "v1=zz," + old, nil},
+		{"empty secrets are ignored", []string{"", "old-secret"}, old, nil},
+		{"unknown secret", []string{"other-secret"}, old, pagerduty.ErrWebhookInvalidSignature},
+		{"retired secret", []string{"new-secret"}, old, pagerduty.ErrWebhookInvalidSignature},
+		{"no secret", nil, old, pagerduty.ErrWebhookInvalidSignature},
+		{"tampered payload", []string{"old-secret"}, testWebhookSignature("old-secret", "{}"), pagerduty.ErrWebhookInvalidSignature},
+		{"no signature", []string{"old-secret"}, "", pagerduty.ErrWebhookNoSignature},
+		{"other signature versions", []string{"old-secret"}, "v2=" + strings.TrimPrefix(old, "v1="), pagerduty.ErrWebhookNoSignature},
+	}
+
+	for _, c := range cases {
+		err := pagerduty.NewWebhookVerifier(c.secrets...).Verify(payload, c.header)
+		if !errors.Is(err, c.wantErr) {
+			t.Errorf("%s: expected error %v, got %v", c.name, c.wantErr, err)
+		}
+	}
+}
+
+func TestWebhookEventDecoding(t *testing.T) {
+	event, err := pagerduty.ParseWebhookPayload([]byte(testWebhookIncidentTriggered))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	incident, err := event.Incident()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if incident.ID != "PINC123" || incident.Number != 42 || incident.Service == nil || incident.Service.ID != "PSVC123" {
+		t.Errorf("unexpected incident %+v", incident)
+	}
+
+	if _, err := event.Service(); err == nil {
+		t.Errorf("expected an error decoding the service of an incident event")
+	}
+
+	if _, err := pagerduty.ParseWebhookPayload([]byte(`{"messages":[]}`)); err == nil {
+		t.Errorf("expected an error parsing a payload without event")
+	}
+}
+
+func TestWebhookHandler(t *testing.T) {
+	var handled, defaulted []string
+
+	h := pagerduty.NewWebhookHandler("old-secret", "new-secret")
+	h.HandleFunc(pagerduty.WebhookEventIncidentTriggered, func(event *pagerduty.WebhookEvent) error {
+		incident, err := event.Incident()
+		if err != nil {
+			return err
+		}
+		handled = append(handled, incident.ID)
+		return nil
+	})
+	h.HandleFunc(pagerduty.WebhookEventIncidentResolved, func(event *pagerduty.WebhookEvent) error {
+		return errors.New("unavailable")
+	})
+
+	serve := func(method, payload, signature string) int {
+		r := httptest.NewRequest(method, "/webhook", bytes.NewBufferString(payload))
+		if signature != "" {
+			r.Header.Set(pagerduty.WebhookSignatureHeader, signature)
+		}
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, r)
+		return w.Code
+	}
+	serveSigned := func(payload string) int {
+		return serve(http.MethodPost, payload, testWebhookSignature("new-secret", payload))
+	}
+
+	resolved := strings.Replace(testWebhookIncidentTriggered, "incident.triggered", "incident.resolved", 1)
+	ping := `{"event":{"id":"01BZ5","event_type":"pagey.ping","resource_type":"pagey","data":{}}}`
+
+	if code := serve(http.MethodPost, testWebhookIncidentTriggered, testWebhookSignature("old-secret", testWebhookIncidentTriggered)); code != http.StatusNoContent {
+		t.Errorf("expected a dispatched event to be acknowledged, got %d", code)
+	}
+	if len(handled) != 1 || handled[0] != "PINC123" {
+		t.Errorf("expected incident PINC123 to be handled, got %v", handled)
+	}
+
+	if code := serveSigned(ping); code != http.StatusNoContent {
+		t.Errorf("expected an event without handler to be acknowledged, got %d", code)
+	}
+
+	h.Default = func(event *pagerduty.WebhookEvent) error {
+		defaulted = append(defaulted, event.EventType)
+		return nil
+	}
+	if code := serveSigned(ping); code != http.StatusNoContent {
+		t.Errorf("expected an event handled by default to be acknowledged, got %d", code)
+	}
+	if len(defaulted) != 1 || defaulted[0] != pagerduty.WebhookEventPageyPing {
+		t.Errorf("expected pagey.ping to be handled by default, got %v", defaulted)
+	}
+
+	if code := serveSigned(resolved); code != http.StatusInternalServerError {
+		t.Errorf("expected a failed handler to be retried with a 500, got %d", code)
+	}
+	if code := serve(http.MethodPost, testWebhookIncidentTriggered, testWebhookSignature("other-secret", testWebhookIncidentTriggered)); code != http.StatusUnauthorized {
+		t.Errorf("expected an invalid signature to be rejected with a 401, got %d", code)
+	}
+	if code := serve(http.MethodPost, testWebhookIncidentTriggered, ""); code != http.StatusUnauthorized {
+		t.Errorf("expected a missing signature to be rejected with a 401, got %d", code)
+	}
+	if code := serveSigned("not json"); code != http.StatusBadRequest {
+		t.Errorf("expected an invalid payload to be rejected with a 400, got %d", code)
+	}
+	large := `{"event":{"id":"01BZ6","event_type":"pagey.ping","resource_type":"pagey","data":{"padding":"` + strings.Repeat("x", 5<<20) + `"}}}`
+	if code := serveSigned(large); code != http.StatusRequestEntityTooLarge {
+		t.Errorf("expected an oversized payload to be rejected with a 413, got %d", code)
+	}
+	if code := serve(http.MethodGet, "", ""); code != http.StatusMethodNotAllowed {
+		t.Errorf("expected a GET to be rejected with a 405, got %d", code)
+	}
+
+	if len(handled) != 1 {
+		t.Errorf("expected rejected requests not to be dispatched, got %v", handled)
+	}
+}